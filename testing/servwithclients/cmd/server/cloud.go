@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+)
+
+// cloudConfig returns the cloud.Configuration for the named cloud: public, china or usgov. endpoint,
+// audience and authority override the ARM endpoint, the ARM token audience and the Azure AD authority host
+// of the named cloud, which allows using a private ARM endpoint. If endpoint is set and audience is not,
+// the audience is the endpoint.
+func cloudConfig(name, endpoint, audience, authority string) (cloud.Configuration, error) {
+	var base cloud.Configuration
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "public":
+		base = cloud.AzurePublic
+	case "china":
+		base = cloud.AzureChina
+	case "usgov":
+		base = cloud.AzureGovernment
+	default:
+		return cloud.Configuration{}, fmt.Errorf("unknown cloud %q, must be one of public, china, usgov", name)
+	}
+
+	// The predefined configurations are package variables, so they must not be modified.
+	cfg := cloud.Configuration{
+		ActiveDirectoryAuthorityHost: base.ActiveDirectoryAuthorityHost,
+		Services:                     maps.Clone(base.Services),
+	}
+	// Importing arm registers ResourceManager in the predefined configurations.
+	rm := cfg.Services[cloud.ResourceManager]
+
+	if audience != "" && endpoint == "" {
+		return cloud.Configuration{}, errors.New("an ARM audience requires an ARM endpoint")
+	}
+	if endpoint != "" {
+		if err := checkURL(endpoint); err != nil {
+			return cloud.Configuration{}, fmt.Errorf("ARM endpoint: %w", err)
+		}
+		if audience == "" {
+			audience = endpoint
+		}
+		rm = cloud.ServiceConfiguration{Endpoint: endpoint, Audience: audience}
+	}
+	cfg.Services[cloud.ResourceManager] = rm
+
+	if authority != "" {
+		if err := checkURL(authority); err != nil {
+			return cloud.Configuration{}, fmt.Errorf("authority host: %w", err)
+		}
+		cfg.ActiveDirectoryAuthorityHost = authority
+	}
+	return cfg, nil
+}
+
+// checkURL makes sure u is an absolute https URL, which is required to send credentials. http is allowed
+// for loopback hosts, such as a local ARM emulator.
+func checkURL(u string) error {
+	p, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	switch {
+	case p.Host == "":
+		return fmt.Errorf("%q must be an https URL", u)
+	case p.Scheme == "https":
+	case p.Scheme == "http" && isLoopback(p.Hostname()):
+	default:
+		return fmt.Errorf("%q must be an https URL, or an http URL of a loopback host", u)
+	}
+	return nil
+}
+
+// isLoopback reports if host is localhost or a loopback IP address.
+func isLoopback(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip, err := netip.ParseAddr(host)
+	return err == nil && ip.IsLoopback()
+}
+
+// armOptions returns the options used to create ARM clients in cfg. If the ARM endpoint is http, which
+// cloudConfig() only allows for loopback hosts, credentials are allowed to be sent over it.
+func armOptions(cfg cloud.Configuration) *arm.ClientOptions {
+	opts := &arm.ClientOptions{ClientOptions: azcore.ClientOptions{Cloud: cfg}}
+	if u, err := url.Parse(cfg.Services[cloud.ResourceManager].Endpoint); err == nil && u.Scheme == "http" {
+		opts.InsecureAllowCredentialWithHTTP = true
+	}
+	return opts
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCloudConfig(t *testing.T) {
+	t.Parallel()
+
+	// cfg is the part of a cloud.Configuration that cloudConfig() sets.
+	type cfg struct {
+		Endpoint  string
+		Audience  string
+		Authority string
+	}
+	public := cfg{
+		Endpoint:  "https://management.azure.com",
+		Audience:  "https://management.core.windows.net/",
+		Authority: "https://login.microsoftonline.com/",
+	}
+
+	tests := []struct {
+		name      string
+		cloud     string
+		endpoint  string
+		audience  string
+		authority string
+		want      cfg
+		wantErr   bool
+	}{
+		{
+			name: "Default is public",
+			want: public,
+		},
+		{
+			name:  "Public, case and spaces are ignored",
+			cloud: " Public ",
+			want:  public,
+		},
+		{
+			name:  "China",
+			cloud: "china",
+			want: cfg{
+				Endpoint:  "https://management.chinacloudapi.cn",
+				Audience:  "https://management.core.chinacloudapi.cn",
+				Authority: "https://login.chinacloudapi.cn/",
+			},
+		},
+		{
+			name:  "US government",
+			cloud: "usgov",
+			want: cfg{
+				Endpoint:  "https://management.usgovcloudapi.net",
+				Audience:  "https://management.core.usgovcloudapi.net",
+				Authority: "https://login.microsoftonline.us/",
+			},
+		},
+		{
+			name:    "Error: unknown cloud",
+			cloud:   "germany",
+			wantErr: true,
+		},
+		{
+			name:     "Endpoint override is also the audience",
+			endpoint: "https://arm.example.com",
+			want:     cfg{Endpoint: "https://arm.example.com", Audience: "https://arm.example.com", Authority: public.Authority},
+		},
+		{
+			name:     "Endpoint and audience override",
+			cloud:    "china",
+			endpoint: "https://arm.example.com",
+			audience: "https://audience.example.com",
+			want:     cfg{Endpoint: "https://arm.example.com", Audience: "https://audience.example.com", Authority: "https://login.chinacloudapi.cn/"},
+		},
+		{
+			name:      "Authority override",
+			authority: "https://login.example.com/",
+			want:      cfg{Endpoint: public.Endpoint, Audience: public.Audience, Authority: "https://login.example.com/"},
+		},
+		{
+			name:     "Error: audience without endpoint",
+			audience: "https://audience.example.com",
+			wantErr:  true,
+		},
+		{
+			name:     "http endpoint on localhost",
+			endpoint: "http://localhost:8080",
+			want:     cfg{Endpoint: "http://localhost:8080", Audience: "http://localhost:8080", Authority: public.Authority},
+		},
+		{
+			name:     "http endpoint on a loopback IPv4 address",
+			endpoint: "http://127.0.0.2:8080",
+			want:     cfg{Endpoint: "http://127.0.0.2:8080", Audience: "http://127.0.0.2:8080", Authority: public.Authority},
+		},
+		{
+			name:     "http endpoint on the loopback IPv6 address",
+			endpoint: "http://[::1]:8080",
+			want:     cfg{Endpoint: "http://[::1]:8080", Audience: "http://[::1]:8080", Authority: public.Authority},
+		},
+		{
+			name:      "http authority on localhost",
+			authority: "http://localhost:8081/",
+			want:      cfg{Endpoint: public.Endpoint, Audience: public.Audience, Authority: "http://localhost:8081/"},
+		},
+		{
+			name:     "Error: endpoint is not https",
+			endpoint: "http://arm.example.com",
+			wantErr:  true,
+		},
+		{
+			name:     "Error: endpoint is http on a host that is not loopback",
+			endpoint: "http://10.0.0.1:8080",
+			wantErr:  true,
+		},
+		{
+			name:     "Error: endpoint has an unknown scheme",
+			endpoint: "ftp://localhost",
+			wantErr:  true,
+		},
+		{
+			name:     "Error: endpoint has no host",
+			endpoint: "arm.example.com",
+			wantErr:  true,
+		},
+		{
+			name:     "Error: endpoint is malformed",
+			endpoint: "https://arm.example.com/%zz",
+			wantErr:  true,
+		},
+		{
+			name:      "Error: authority is not https",
+			authority: "http://login.example.com/",
+			wantErr:   true,
+		},
+		{
+			name:      "Error: authority is malformed",
+			authority: "https://login example.com/",
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := cloudConfig(test.cloud, test.endpoint, test.audience, test.authority)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestCloudConfig(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestCloudConfig(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		rm := got.Services[cloud.ResourceManager]
+		if diff := cmp.Diff(test.want, cfg{Endpoint: rm.Endpoint, Audience: rm.Audience, Authority: got.ActiveDirectoryAuthorityHost}); diff != "" {
+			t.Errorf("TestCloudConfig(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+
+	// Overrides must not leak into the predefined configurations, which are shared package variables.
+	if got := cloud.AzurePublic.Services[cloud.ResourceManager].Endpoint; got != public.Endpoint {
+		t.Errorf("TestCloudConfig: cloud.AzurePublic endpoint got %q, want %q", got, public.Endpoint)
+	}
+}
+
+func TestARMOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		endpoint     string
+		wantInsecure bool
+	}{
+		{
+			name:     "https endpoint",
+			endpoint: "https://arm.example.com",
+		},
+		{
+			name:         "http endpoint on loopback",
+			endpoint:     "http://localhost:8080",
+			wantInsecure: true,
+		},
+	}
+
+	for _, test := range tests {
+		cfg, err := cloudConfig("", test.endpoint, "", "")
+		if err != nil {
+			t.Errorf("TestARMOptions(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		}
+		opts := armOptions(cfg)
+		if opts.InsecureAllowCredentialWithHTTP != test.wantInsecure {
+			t.Errorf("TestARMOptions(%s): got InsecureAllowCredentialWithHTTP == %v, want %v", test.name, opts.InsecureAllowCredentialWithHTTP, test.wantInsecure)
+		}
+		if got := opts.Cloud.Services[cloud.ResourceManager].Endpoint; got != test.endpoint {
+			t.Errorf("TestARMOptions(%s): got endpoint %q, want %q", test.name, got, test.endpoint)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Command server runs server.Server, proxying the greeter service and the Azure Resource Manager over gRPC.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	gpb "github.com/element-of-surprise/examples/testing/servwithclients/proto/greeter/proto"
+	"github.com/element-of-surprise/examples/testing/servwithclients/server"
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+var (
+	addr            = flag.String("addr", ":8080", "The address the server listens on")
+	greeterAddr     = flag.String("greeter", "localhost:50051", "The address of the greeter service")
+	subscription    = flag.String("subscription", os.Getenv("AZURE_SUBSCRIPTION_ID"), "The Azure subscription ID to manage resource groups in")
+	credentials     = flag.String("credentials", "default", "Comma separated list of credentials to try in order: default, env, workload, managed, cli")
+	shutdownTimeout = flag.Duration("shutdown-timeout", 30*time.Second, "How long to wait for in-flight RPCs on shutdown")
+	greeterAttempts = flag.Int("greeter-attempts", server.DefaultRetryPolicy().MaxAttempts, "The maximum number of attempts for a call to the greeter")
+	greeterTimeout  = flag.Duration("greeter-attempt-timeout", 0, "If set, the timeout for each attempt to call the greeter")
+	pollFrequency   = flag.Duration("poll-frequency", 10*time.Second, "How often ARM is polled for the status of long-running operations")
+	operationDir    = flag.String("operation-dir", "", "If set, long-running operations are persisted in this directory and resumed on restart")
+	allowedRegions  = flag.String("allowed-regions", "", "Comma separated list of regions resource groups can be created in. If empty, any region is allowed")
+	pageTokenKey    = flag.String("page-token-key-file", "", "A file holding the key used to sign page tokens. Replicas must share a key")
+	allowedSubs     = flag.String("allowed-subscriptions", "", "Comma separated list of subscriptions, in addition to --subscription, that requests may act on")
+	clientPoolSize  = flag.Int("client-pool-size", 16, "The maximum number of clients kept for --allowed-subscriptions")
+	cloudName       = flag.String("cloud", "public", "The Azure cloud to use: public, china or usgov")
+	armEndpoint     = flag.String("arm-endpoint", "", "If set, overrides the ARM endpoint of --cloud, such as for a private ARM endpoint. Must be https, or http for a loopback host such as a local emulator")
+	armAudience     = flag.String("arm-audience", "", "If set, overrides the audience of ARM tokens. Defaults to --arm-endpoint if it is set")
+	authorityHost   = flag.String("authority-host", "", "If set, overrides the Azure AD authority host of --cloud")
+	rgCacheTTL      = flag.Duration("rg-cache-ttl", 0, "If set, resource group reads and lists are cached for this long")
+	rgCacheEntries  = flag.Int("rg-cache-entries", 10000, "The maximum number of entries kept when --rg-cache-ttl is set")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	if *subscription == "" {
+		return errors.New("--subscription or AZURE_SUBSCRIPTION_ID must be set")
+	}
+
+	cfg, err := cloudConfig(*cloudName, *armEndpoint, *armAudience, *authorityHost)
+	if err != nil {
+		return err
+	}
+	cred, err := credentialChain(*credentials, azcore.ClientOptions{Cloud: cfg})
+	if err != nil {
+		return err
+	}
+	factory, err := armresources.NewClientFactory(*subscription, cred, armOptions(cfg))
+	if err != nil {
+		return fmt.Errorf("could not create ARM clients: %w", err)
+	}
+
+	conn, err := grpc.NewClient(*greeterAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("could not create greeter client(%s): %w", *greeterAddr, err)
+	}
+	defer conn.Close()
+
+	retry := server.DefaultRetryPolicy()
+	retry.MaxAttempts = *greeterAttempts
+	retry.PerAttemptTimeout = *greeterTimeout
+
+	options := []server.Option{server.WithRetryPolicy(retry), server.WithPollFrequency(*pollFrequency), server.WithSubscriptionID(*subscription), server.WithClientFactory(factory)}
+	if *allowedRegions != "" {
+		options = append(options, server.WithAllowedRegions(strings.Split(*allowedRegions, ",")...))
+	}
+	if *allowedSubs != "" {
+		newFactory := func(sub string) (*armresources.ClientFactory, error) {
+			return armresources.NewClientFactory(sub, cred, armOptions(cfg))
+		}
+		options = append(
+			options,
+			server.WithSubscriptionFactories(newFactory, strings.Split(*allowedSubs, ",")...),
+			server.WithClientPoolSize(*clientPoolSize),
+		)
+	}
+	if *operationDir != "" {
+		options = append(options, server.WithOperationDir(*operationDir))
+	}
+	if *rgCacheTTL > 0 {
+		options = append(options, server.WithResourceGroupCache(*rgCacheTTL, *rgCacheEntries))
+	}
+	if *pageTokenKey != "" {
+		key, err := os.ReadFile(*pageTokenKey)
+		if err != nil {
+			return fmt.Errorf("could not read page token key: %w", err)
+		}
+		options = append(options, server.WithPageTokenKey(key))
+	}
+
+	serv, err := server.New(gpb.NewGreeterClient(conn), factory.NewResourceGroupsClient(), options...)
+	if err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s: %w", *addr, err)
+	}
+
+	gs := grpc.NewServer()
+	pb.RegisterRPCServer(gs, serv)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("server listening on %s", lis.Addr())
+		errCh <- gs.Serve(lis)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Println("shutting down")
+	shutdown(gs, *shutdownTimeout)
+	return nil
+}
+
+// shutdown gracefully stops gs, forcing a stop if in-flight RPCs have not finished within timeout.
+func shutdown(gs *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		gs.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		log.Println("graceful shutdown timed out, forcing stop")
+		gs.Stop()
+	}
+}
+
+// credentialChain builds an azcore.TokenCredential from a comma separated list of credential names.
+// The credentials are tried in the order they are listed. opts selects the cloud the credentials
+// authenticate with. The cli credential uses the cloud the Azure CLI is logged in to.
+func credentialChain(names string, opts azcore.ClientOptions) (azcore.TokenCredential, error) {
+	var creds []azcore.TokenCredential
+	for _, name := range strings.Split(names, ",") {
+		var (
+			cred azcore.TokenCredential
+			err  error
+		)
+		switch strings.TrimSpace(name) {
+		case "default":
+			cred, err = azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{ClientOptions: opts})
+		case "env":
+			cred, err = azidentity.NewEnvironmentCredential(&azidentity.EnvironmentCredentialOptions{ClientOptions: opts})
+		case "workload":
+			cred, err = azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{ClientOptions: opts})
+		case "managed":
+			cred, err = azidentity.NewManagedIdentityCredential(&azidentity.ManagedIdentityCredentialOptions{ClientOptions: opts})
+		case "cli":
+			cred, err = azidentity.NewAzureCLICredential(nil)
+		default:
+			return nil, fmt.Errorf("unknown credential type %q", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not create %s credential: %w", name, err)
+		}
+		creds = append(creds, cred)
+	}
+
+	if len(creds) == 1 {
+		return creds[0], nil
+	}
+	return azidentity.NewChainedTokenCredential(creds, nil)
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// apiVersionTTL is how long the API versions of a resource provider are cached.
+const apiVersionTTL = time.Hour
+
+// apiVersionCache caches the API version to use for each resource type, as resolved through the
+// providers API. The zero value is ready to use.
+type apiVersionCache struct {
+	mu sync.Mutex
+	// providers is keyed by the lower cased subscription and provider namespace.
+	providers map[string]apiVersionEntry
+}
+
+type apiVersionEntry struct {
+	// versions maps the lower cased resource type, without the namespace, to its API version.
+	versions map[string]string
+	expires  time.Time
+}
+
+// resolve returns the API version to use for resources of type rt in subscription. If the provider
+// of rt is not cached, it is read with client.
+func (c *apiVersionCache) resolve(ctx context.Context, client providersClient, subscription string, rt arm.ResourceType) (string, error) {
+	key := strings.ToLower(subscription + "/" + rt.Namespace)
+
+	c.mu.Lock()
+	e, ok := c.providers[key]
+	c.mu.Unlock()
+
+	if !ok || time.Now().After(e.expires) {
+		resp, err := client.Get(ctx, rt.Namespace, nil)
+		if err != nil {
+			return "", azError(err)
+		}
+		e = apiVersionEntry{versions: providerAPIVersions(resp.Provider), expires: time.Now().Add(apiVersionTTL)}
+
+		c.mu.Lock()
+		if c.providers == nil {
+			c.providers = map[string]apiVersionEntry{}
+		}
+		c.providers[key] = e
+		c.mu.Unlock()
+	}
+
+	typ := strings.Join(rt.Types, "/")
+	v, ok := e.versions[strings.ToLower(typ)]
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "resource provider %s has no resource type %s", rt.Namespace, typ)
+	}
+	return v, nil
+}
+
+// providerAPIVersions returns the API version to use for each resource type of p.
+func providerAPIVersions(p armresources.Provider) map[string]string {
+	m := make(map[string]string, len(p.ResourceTypes))
+	for _, rt := range p.ResourceTypes {
+		if rt == nil || rt.ResourceType == nil {
+			continue
+		}
+		if v := pickAPIVersion(rt); v != "" {
+			m[strings.ToLower(*rt.ResourceType)] = v
+		}
+	}
+	return m
+}
+
+// pickAPIVersion returns the newest stable API version of rt. If there is none, the default API
+// version is used, then the newest preview version. API versions are dates, optionally followed by
+// a suffix such as "-preview", so newer versions sort after older ones.
+func pickAPIVersion(rt *armresources.ProviderResourceType) string {
+	stable, preview := "", ""
+	for _, v := range rt.APIVersions {
+		switch {
+		case v == nil:
+		case strings.Count(*v, "-") > 2:
+			// A date with a suffix, such as "2023-01-01-preview" or "2023-01-01-beta".
+			if *v > preview {
+				preview = *v
+			}
+		case *v > stable:
+			stable = *v
+		}
+	}
+	switch {
+	case stable != "":
+		return stable
+	case deref(rt.DefaultAPIVersion) != "":
+		return *rt.DefaultAPIVersion
+	}
+	return preview
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources/fake"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mustFakeProvidersClient(calls *fakeProvidersCalls) providersClient {
+	fs := newProvidersServer(calls)
+	client, err := armresources.NewProvidersClient(
+		"subscriptionID",
+		&azfake.TokenCredential{},
+		&arm.ClientOptions{
+			ClientOptions: azcore.ClientOptions{
+				Transport: fake.NewProvidersServerTransport(&fs),
+			},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func TestPickAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		rt   *armresources.ProviderResourceType
+		want string
+	}{
+		{
+			name: "Newest stable version",
+			rt: &armresources.ProviderResourceType{
+				APIVersions:       []*string{toPtr("2023-05-01-preview"), toPtr("2022-09-01"), toPtr("2023-01-01"), toPtr("2021-01-01")},
+				DefaultAPIVersion: toPtr("2021-01-01"),
+			},
+			want: "2023-01-01",
+		},
+		{
+			name: "Default version if there is no stable version",
+			rt: &armresources.ProviderResourceType{
+				APIVersions:       []*string{toPtr("2023-05-01-preview")},
+				DefaultAPIVersion: toPtr("2021-01-01-preview"),
+			},
+			want: "2021-01-01-preview",
+		},
+		{
+			name: "Newest preview version",
+			rt: &armresources.ProviderResourceType{
+				APIVersions: []*string{toPtr("2022-05-01-preview"), toPtr("2023-05-01-beta"), nil},
+			},
+			want: "2023-05-01-beta",
+		},
+		{
+			name: "No versions",
+			rt:   &armresources.ProviderResourceType{},
+		},
+	}
+
+	for _, test := range tests {
+		if got := pickAPIVersion(test.rt); got != test.want {
+			t.Errorf("TestPickAPIVersion(%s): got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestAPIVersionCache(t *testing.T) {
+	t.Parallel()
+
+	network := armresources.ProvidersClientGetResponse{
+		Provider: armresources.Provider{
+			Namespace: toPtr("Microsoft.Network"),
+			ResourceTypes: []*armresources.ProviderResourceType{
+				{ResourceType: toPtr("virtualNetworks"), APIVersions: []*string{toPtr("2023-04-01"), toPtr("2022-01-01")}},
+				{ResourceType: toPtr("virtualNetworks/subnets"), APIVersions: []*string{toPtr("2023-02-01")}},
+			},
+		},
+	}
+	calls := &fakeProvidersCalls{get: []any{network, errors.New("error"), network}}
+	client := mustFakeProvidersClient(calls)
+	c := &apiVersionCache{}
+
+	steps := []struct {
+		name     string
+		sub      string
+		id       string
+		expire   bool
+		want     string
+		wantCode codes.Code
+	}{
+		{
+			name: "Reads provider",
+			sub:  "sub",
+			id:   "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+			want: "2023-04-01",
+		},
+		{
+			name: "Child type is cached, case insensitive",
+			sub:  "SUB",
+			id:   "/subscriptions/sub/resourceGroups/rg/providers/microsoft.network/virtualnetworks/vnet/subnets/default",
+			want: "2023-02-01",
+		},
+		{
+			name:     "Unknown type",
+			sub:      "sub",
+			id:       "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/nothing/x",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Other subscription reads provider and fails",
+			sub:      "other",
+			id:       "/subscriptions/other/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+			wantCode: codes.Unknown,
+		},
+		{
+			name:   "Expired entry reads provider",
+			sub:    "sub",
+			id:     "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+			expire: true,
+			want:   "2023-04-01",
+		},
+	}
+
+	for _, step := range steps {
+		if step.expire {
+			c.mu.Lock()
+			for k, e := range c.providers {
+				e.expires = time.Now().Add(-time.Second)
+				c.providers[k] = e
+			}
+			c.mu.Unlock()
+		}
+		id, err := arm.ParseResourceID(step.id)
+		if err != nil {
+			panic(err)
+		}
+		got, err := c.resolve(context.Background(), client, step.sub, id.ResourceType)
+		if status.Code(err) != step.wantCode {
+			t.Errorf("TestAPIVersionCache(%s): got code %v, want %v: %s", step.name, status.Code(err), step.wantCode, err)
+			continue
+		}
+		if got != step.want {
+			t.Errorf("TestAPIVersionCache(%s): got %q, want %q", step.name, got, step.want)
+		}
+	}
+
+	if diff := cmp.Diff([]string{"Microsoft.Network", "Microsoft.Network", "Microsoft.Network"}, calls.gotGet); diff != "" {
+		t.Errorf("TestAPIVersionCache: providers read -want/+got:\n%s", diff)
+	}
+}
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// rgCache is a read-through cache of the resource groups returned by ReadResourceGroup() and the
+// pages returned by ListResourceGroups(). A nil *rgCache caches nothing.
+type rgCache struct {
+	mu sync.Mutex
+
+	ttl        time.Duration
+	maxEntries int
+	// entries is keyed by the lower cased subscription, followed by the group name or the list request.
+	entries map[string]*rgCacheEntry
+	// clock is incremented on each get() or put() to track which entry was used least recently.
+	clock uint64
+	// now returns the current time. If nil, time.Now is used.
+	now func() time.Time
+}
+
+type rgCacheEntry struct {
+	value   proto.Message
+	expires time.Time
+	used    uint64
+}
+
+// newRGCache creates an rgCache that keeps at most maxEntries entries, each for at most ttl.
+func newRGCache(ttl time.Duration, maxEntries int) *rgCache {
+	return &rgCache{ttl: ttl, maxEntries: maxEntries, entries: map[string]*rgCacheEntry{}}
+}
+
+// groupKey returns the key of the resource group name in subscription.
+func groupKey(subscription, name string) string {
+	return strings.ToLower(subscription + "/group/" + name)
+}
+
+// listPrefix is the prefix of the keys of all pages of resource groups in subscription.
+func listPrefix(subscription string) string {
+	return strings.ToLower(subscription) + "/list/"
+}
+
+// listKey returns the key of a page of resource groups in subscription. filter is the hash of the
+// list filters, size the page size and token the page token of the request.
+func listKey(subscription, filter string, size int, token string) string {
+	return listPrefix(subscription) + fmt.Sprintf("%s/%d/%s", filter, size, token)
+}
+
+// getGroup returns a copy of the cached resource group name in subscription.
+func (c *rgCache) getGroup(subscription, name string) (*pb.ResourceGroup, bool) {
+	v, ok := c.get(groupKey(subscription, name))
+	if !ok {
+		return nil, false
+	}
+	return v.(*pb.ResourceGroup), true
+}
+
+// putGroup caches rg as the resource group name in subscription.
+func (c *rgCache) putGroup(subscription, name string, rg *pb.ResourceGroup) {
+	c.put(groupKey(subscription, name), rg)
+}
+
+// getList returns a copy of the cached page with key, which was created by listKey().
+func (c *rgCache) getList(key string) (*pb.ListResourceGroupsReply, bool) {
+	v, ok := c.get(key)
+	if !ok {
+		return nil, false
+	}
+	return v.(*pb.ListResourceGroupsReply), true
+}
+
+// putList caches reply as the page with key, which was created by listKey().
+func (c *rgCache) putList(key string, reply *pb.ListResourceGroupsReply) {
+	c.put(key, reply)
+}
+
+// get returns a copy of the value with key, if it is cached and has not expired.
+func (c *rgCache) get(key string) (proto.Message, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.timeNow().Before(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.clock++
+	e.used = c.clock
+	return proto.Clone(e.value), true
+}
+
+// put caches a copy of v with key.
+func (c *rgCache) put(key string, v proto.Message) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clock++
+	if _, ok := c.entries[key]; !ok {
+		for len(c.entries) >= c.maxEntries {
+			c.evict()
+		}
+	}
+	c.entries[key] = &rgCacheEntry{
+		value:   proto.Clone(v),
+		expires: c.timeNow().Add(c.ttl),
+		used:    c.clock,
+	}
+}
+
+// invalidate removes the resource group name in subscription and every cached page of resource groups
+// in subscription, as any of them may include the group.
+func (c *rgCache) invalidate(subscription, name string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, groupKey(subscription, name))
+	lists := listPrefix(subscription)
+	for key := range c.entries {
+		if strings.HasPrefix(key, lists) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// evict removes the expired entries. If there are none, it removes the least recently used entry.
+// c.mu must be held.
+func (c *rgCache) evict() {
+	now := c.timeNow()
+	oldest, used, expired := "", uint64(0), false
+	for key, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, key)
+			expired = true
+			continue
+		}
+		if oldest == "" || e.used < used {
+			oldest, used = key, e.used
+		}
+	}
+	if !expired {
+		delete(c.entries, oldest)
+	}
+}
+
+func (c *rgCache) timeNow() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
+
+// cacheSubscription returns the subscription resource groups in subscription are cached under. An empty
+// subscription is the server's subscription.
+func (s *Server) cacheSubscription(subscription string) string {
+	if subscription == "" {
+		return s.subscriptionID
+	}
+	return subscription
+}
+
+// invalidateGroup removes the resource group name in subscription, and the pages that may list it, from the cache.
+func (s *Server) invalidateGroup(subscription, name string) {
+	s.rgCache.invalidate(s.cacheSubscription(subscription), name)
+}
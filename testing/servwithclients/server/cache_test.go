@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func TestRGCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := newRGCache(time.Minute, 3)
+	c.now = func() time.Time { return now }
+
+	group := func(region string) *pb.ResourceGroup {
+		return &pb.ResourceGroup{Name: "rg", Region: region}
+	}
+	page := func(region string) *pb.ListResourceGroupsReply {
+		return &pb.ListResourceGroupsReply{ResourceGroups: []*pb.ResourceGroup{group(region)}}
+	}
+	subAList := listKey("A", "filter", 100, "")
+	subBList := listKey("b", "filter", 100, "")
+
+	steps := []struct {
+		name    string
+		do      func()
+		key     string
+		want    string // The region of the cached group, or "" if the key should not be cached.
+		advance time.Duration
+	}{
+		{name: "miss", key: groupKey("a", "rg")},
+		{name: "hit, case insensitive", do: func() { c.putGroup("A", "RG", group("westus")) }, key: groupKey("a", "rg"), want: "westus"},
+		{name: "list hit", do: func() { c.putList(subAList, page("eastus")) }, key: listKey("a", "filter", 100, ""), want: "eastus"},
+		{name: "page token is case sensitive", key: listKey("a", "filter", 100, "TOKEN")},
+		{
+			name: "invalidate removes the group and lists of its subscription",
+			do: func() {
+				c.putList(subBList, page("centralus"))
+				c.invalidate("a", "rg")
+			},
+			key: subAList,
+		},
+		{name: "invalidate kept lists of other subscriptions", key: subBList, want: "centralus"},
+		{name: "entry expires", advance: time.Minute, key: subBList},
+		{
+			name: "least recently used is evicted",
+			do: func() {
+				c.putGroup("a", "one", group("westus"))
+				c.putGroup("a", "two", group("westus"))
+				c.putGroup("a", "three", group("westus"))
+				c.getGroup("a", "one")
+				c.putGroup("a", "four", group("westus"))
+			},
+			key: groupKey("a", "two"),
+		},
+		{name: "recently used is kept", key: groupKey("a", "one"), want: "westus"},
+		{
+			name:    "expired entries are evicted first",
+			advance: 30 * time.Second,
+			do: func() {
+				c.putGroup("a", "five", group("eastus"))
+				now = now.Add(45 * time.Second)
+				c.putGroup("a", "six", group("eastus"))
+				c.putGroup("a", "seven", group("eastus"))
+			},
+			key:  groupKey("a", "five"),
+			want: "eastus",
+		},
+	}
+
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		if step.do != nil {
+			step.do()
+		}
+		v, ok := c.get(step.key)
+		got := ""
+		switch v := v.(type) {
+		case *pb.ResourceGroup:
+			got = v.Region
+		case *pb.ListResourceGroupsReply:
+			got = v.ResourceGroups[0].Region
+		}
+		if ok != (step.want != "") || got != step.want {
+			t.Errorf("TestRGCache(%s): got %q, %v, want %q", step.name, got, ok, step.want)
+		}
+	}
+
+	got, _ := c.getGroup("a", "five")
+	got.Region = "changed"
+	if got, _ := c.getGroup("a", "five"); got.Region != "eastus" {
+		t.Errorf("TestRGCache(copy): got region %q, want %q", got.Region, "eastus")
+	}
+
+	var nilCache *rgCache
+	nilCache.putGroup("a", "rg", group("westus"))
+	nilCache.invalidate("a", "rg")
+	if _, ok := nilCache.getGroup("a", "rg"); ok {
+		t.Errorf("TestRGCache(nil cache): got cached group, want none")
+	}
+}
+
+func TestResourceGroupCache(t *testing.T) {
+	t.Parallel()
+
+	calls := &fakeResourceCalls{}
+	tagCalls := &fakeTagsCalls{}
+	genericCalls := &fakeGenericCalls{}
+	s := &Server{
+		resourceClient: mustFakeResourceGroupClient(calls),
+		tagsClient:     mustFakeTagsClient(tagCalls),
+		genericClient:  mustFakeGenericClient(genericCalls),
+		subscriptionID: "subscriptionID",
+		ops:            operations{pollFrequency: time.Millisecond},
+		rgCache:        newRGCache(time.Minute, 10),
+	}
+	ctx := context.Background()
+
+	// setARM makes ARM return the resource group in region.
+	setARM := func(region string) {
+		rg := armresources.ResourceGroup{ID: toPtr("/subscriptions/subscriptionID/resourceGroups/rg"), Name: toPtr("rg"), Location: toPtr(region)}
+		calls.get = []any{armresources.ResourceGroupsClientGetResponse{ResourceGroup: rg}}
+		calls.list = []any{armresources.ResourceGroupsClientListResponse{ResourceGroupListResult: armresources.ResourceGroupListResult{Value: []*armresources.ResourceGroup{&rg}}}}
+		calls.createOrUpdate = []any{armresources.ResourceGroupsClientCreateOrUpdateResponse{ResourceGroup: rg}}
+		calls.update = []any{armresources.ResourceGroupsClientUpdateResponse{ResourceGroup: rg}}
+		calls.beginDelete = []any{armresources.ResourceGroupsClientDeleteResponse{}}
+		tagCalls.updateAtScope = []any{armresources.TagsClientUpdateAtScopeResponse{TagsResource: tagsResource(map[string]*string{"owner": toPtr("bob")})}}
+		generic := armresources.GenericResource{ID: rg.ID, Name: rg.Name, Location: rg.Location}
+		genericCalls.beginCreateByID = []any{armresources.ClientCreateOrUpdateByIDResponse{GenericResource: generic}}
+		genericCalls.beginUpdateByID = []any{armresources.ClientUpdateByIDResponse{GenericResource: generic}}
+		genericCalls.beginDeleteByID = []any{armresources.ClientDeleteByIDResponse{}}
+	}
+	read := func(in *pb.ReadResourceGroupRequest) func() (string, error) {
+		return func() (string, error) {
+			resp, err := s.ReadResourceGroup(ctx, in)
+			if err != nil {
+				return "", err
+			}
+			return resp.ResourceGroup.Region, nil
+		}
+	}
+	list := func(in *pb.ListResourceGroupsRequest) func() (string, error) {
+		return func() (string, error) {
+			resp, err := s.ListResourceGroups(ctx, in)
+			if err != nil {
+				return "", err
+			}
+			return resp.ResourceGroups[0].Region, nil
+		}
+	}
+	update := func() (string, error) {
+		_, err := s.UpdateResourceGroup(ctx, &pb.UpdateResourceGroupRequest{Name: "rg", ManagedBy: "manager", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ManagedBy"}}})
+		return "", err
+	}
+	create := func() (string, error) {
+		_, err := s.CreateResourceGroup(ctx, &pb.CreateResourceGroupRequest{Name: "rg", Region: "westeurope"})
+		return "", err
+	}
+	remove := func() (string, error) {
+		resp, err := s.DeleteResourceGroup(ctx, &pb.DeleteResourceGroupRequest{Id: "rg"})
+		if err != nil {
+			return "", err
+		}
+		_, err = s.WaitOperation(ctx, &pb.WaitOperationRequest{Id: resp.OperationId})
+		return "", err
+	}
+	// resourceOp calls one of the generic resource RPCs on the resource group and waits for it.
+	resourceOp := func(call func() (string, error)) func() (string, error) {
+		return func() (string, error) {
+			id, err := call()
+			if err != nil {
+				return "", err
+			}
+			_, err = s.WaitOperation(ctx, &pb.WaitOperationRequest{Id: id})
+			return "", err
+		}
+	}
+	const rgID = "/subscriptions/subscriptionID/resourceGroups/rg"
+	createVnet := resourceOp(func() (string, error) {
+		resp, err := s.CreateOrUpdateResource(ctx, &pb.CreateOrUpdateResourceRequest{Id: vnetID, ApiVersion: "2021-04-01", Region: "westus"})
+		return resp.GetOperationId(), err
+	})
+	createResource := resourceOp(func() (string, error) {
+		resp, err := s.CreateOrUpdateResource(ctx, &pb.CreateOrUpdateResourceRequest{Id: rgID, ApiVersion: "2021-04-01", Region: "westus"})
+		return resp.GetOperationId(), err
+	})
+	updateResource := resourceOp(func() (string, error) {
+		resp, err := s.UpdateResource(ctx, &pb.UpdateResourceRequest{Id: rgID, ApiVersion: "2021-04-01", Tags: map[string]string{"owner": "bob"}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"Tags"}}})
+		return resp.GetOperationId(), err
+	})
+	deleteResource := resourceOp(func() (string, error) {
+		resp, err := s.DeleteResource(ctx, &pb.DeleteResourceRequest{Id: rgID, ApiVersion: "2021-04-01"})
+		return resp.GetOperationId(), err
+	})
+
+	patchTags := func(scope string) func() (string, error) {
+		return func() (string, error) {
+			_, err := s.PatchTagsAtScope(ctx, &pb.PatchTagsAtScopeRequest{Scope: scope, TagOperation: pb.TagOperation_TAG_OPERATION_MERGE, Tags: map[string]string{"owner": "bob"}})
+			return "", err
+		}
+	}
+
+	steps := []struct {
+		name string
+		arm  string
+		call func() (string, error)
+		want string
+	}{
+		{name: "read from ARM", arm: "westus", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "westus"},
+		{name: "read from cache", arm: "eastus", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "westus"},
+		{name: "read ID from cache", arm: "eastus", call: read(&pb.ReadResourceGroupRequest{Id: "/subscriptions/subscriptionID/resourceGroups/RG"}), want: "westus"},
+		{name: "read bypassing cache", arm: "eastus", call: read(&pb.ReadResourceGroupRequest{Id: "rg", BypassCache: true}), want: "eastus"},
+		{name: "bypass refreshed cache", arm: "centralus", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "eastus"},
+		{name: "list from ARM", arm: "centralus", call: list(&pb.ListResourceGroupsRequest{}), want: "centralus"},
+		{name: "list from cache", arm: "northeurope", call: list(&pb.ListResourceGroupsRequest{}), want: "centralus"},
+		{name: "list bypassing cache", arm: "northeurope", call: list(&pb.ListResourceGroupsRequest{BypassCache: true}), want: "northeurope"},
+		{name: "update", arm: "westeurope", call: update},
+		{name: "read after update", arm: "westeurope", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "westeurope"},
+		{name: "list after update", arm: "westeurope", call: list(&pb.ListResourceGroupsRequest{}), want: "westeurope"},
+		{name: "create", arm: "uksouth", call: create},
+		{name: "read after create", arm: "uksouth", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "uksouth"},
+		{name: "list after create", arm: "uksouth", call: list(&pb.ListResourceGroupsRequest{}), want: "uksouth"},
+		{name: "delete", arm: "ukwest", call: remove},
+		{name: "read after delete", arm: "ukwest", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "ukwest"},
+		{name: "list after delete", arm: "ukwest", call: list(&pb.ListResourceGroupsRequest{}), want: "ukwest"},
+		{name: "patch tags of a resource in the group", arm: "francecentral", call: patchTags(vnetID)},
+		{name: "read after resource tags patch", arm: "francecentral", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "ukwest"},
+		{name: "patch tags of the group", arm: "francecentral", call: patchTags("/subscriptions/subscriptionID/resourceGroups/rg")},
+		{name: "read after group tags patch", arm: "francecentral", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "francecentral"},
+		{name: "list after group tags patch", arm: "francecentral", call: list(&pb.ListResourceGroupsRequest{}), want: "francecentral"},
+		{name: "create a resource in the group", arm: "southafricanorth", call: createVnet},
+		{name: "read after resource create", arm: "southafricanorth", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "francecentral"},
+		{name: "create the group as a resource", arm: "southafricanorth", call: createResource},
+		{name: "read after group create as a resource", arm: "southafricanorth", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "southafricanorth"},
+		{name: "list after group create as a resource", arm: "southafricanorth", call: list(&pb.ListResourceGroupsRequest{}), want: "southafricanorth"},
+		{name: "update the group as a resource", arm: "japaneast", call: updateResource},
+		{name: "read after group update as a resource", arm: "japaneast", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "japaneast"},
+		{name: "list after group update as a resource", arm: "japaneast", call: list(&pb.ListResourceGroupsRequest{}), want: "japaneast"},
+		{name: "delete the group as a resource", arm: "koreacentral", call: deleteResource},
+		{name: "read after group delete as a resource", arm: "koreacentral", call: read(&pb.ReadResourceGroupRequest{Id: "rg"}), want: "koreacentral"},
+		{name: "list after group delete as a resource", arm: "koreacentral", call: list(&pb.ListResourceGroupsRequest{}), want: "koreacentral"},
+	}
+
+	for _, step := range steps {
+		setARM(step.arm)
+		got, err := step.call()
+		if err != nil {
+			t.Fatalf("TestResourceGroupCache(%s): got err == %s, want err == nil", step.name, err)
+		}
+		if got != step.want {
+			t.Errorf("TestResourceGroupCache(%s): got region %q, want %q", step.name, got, step.want)
+		}
+	}
+}
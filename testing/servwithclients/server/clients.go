@@ -0,0 +1,121 @@
+package server
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultClientPoolSize is how many subscription clients are kept if WithClientPoolSize() is not used.
+const defaultClientPoolSize = 16
+
+// subscriptionClients are the ARM clients for one subscription.
+type subscriptionClients struct {
+	groups      resourceClient
+	resources   genericClient
+	providers   providersClient
+	deployments deploymentsClient
+	tags        tagsClient
+}
+
+// newSubscriptionClients creates the clients for the subscription of f.
+func newSubscriptionClients(f *armresources.ClientFactory) *subscriptionClients {
+	return &subscriptionClients{
+		groups:      f.NewResourceGroupsClient(),
+		resources:   f.NewClient(),
+		providers:   f.NewProvidersClient(),
+		deployments: f.NewDeploymentsClient(),
+		tags:        f.NewTagsClient(),
+	}
+}
+
+// clientPool lazily creates clients for the subscriptions in an allow-list. It keeps at most size
+// clients, discarding the least recently used client when a new one is needed.
+type clientPool[T any] struct {
+	mu sync.Mutex
+
+	newClient func(subscription string) (T, error)
+	// allowed holds the lower cased subscriptions clients can be created for.
+	allowed map[string]struct{}
+	// size is the maximum number of clients. If zero, defaultClientPoolSize is used.
+	size    int
+	clients map[string]*pooledClient[T]
+	// clock is incremented on each get() to track which client was used least recently.
+	clock uint64
+}
+
+type pooledClient[T any] struct {
+	client T
+	used   uint64
+}
+
+// newClientPool creates a clientPool that creates clients with newClient for the allowed subscriptions.
+func newClientPool[T any](newClient func(subscription string) (T, error), allowed []string) (*clientPool[T], error) {
+	if newClient == nil {
+		return nil, errors.New("a client constructor is required")
+	}
+	if len(allowed) == 0 {
+		return nil, errors.New("at least one subscription must be allowed")
+	}
+
+	p := &clientPool[T]{newClient: newClient, allowed: make(map[string]struct{}, len(allowed))}
+	for _, sub := range allowed {
+		sub = strings.TrimSpace(sub)
+		if sub == "" {
+			return nil, errors.New("allowed subscriptions cannot contain an empty subscription")
+		}
+		p.allowed[strings.ToLower(sub)] = struct{}{}
+	}
+	return p, nil
+}
+
+// get returns the client for subscription, creating it if needed. It returns a PermissionDenied
+// error if subscription isn't allowed.
+func (p *clientPool[T]) get(subscription string) (T, error) {
+	var zero T
+	key := strings.ToLower(subscription)
+	if _, ok := p.allowed[key]; !ok {
+		return zero, status.Errorf(codes.PermissionDenied, "server does not manage subscription %q", subscription)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.clock++
+	if pc, ok := p.clients[key]; ok {
+		pc.used = p.clock
+		return pc.client, nil
+	}
+
+	client, err := p.newClient(subscription)
+	if err != nil {
+		return zero, status.Errorf(codes.Internal, "could not create client for subscription %q: %s", subscription, err)
+	}
+	if p.clients == nil {
+		p.clients = map[string]*pooledClient[T]{}
+	}
+	size := p.size
+	if size == 0 {
+		size = defaultClientPoolSize
+	}
+	for len(p.clients) >= size {
+		p.evict()
+	}
+	p.clients[key] = &pooledClient[T]{client: client, used: p.clock}
+	return client, nil
+}
+
+// evict removes the least recently used client. p.mu must be held.
+func (p *clientPool[T]) evict() {
+	oldest, used := "", uint64(0)
+	for key, pc := range p.clients {
+		if oldest == "" || pc.used < used {
+			oldest, used = key, pc.used
+		}
+	}
+	delete(p.clients, oldest)
+}
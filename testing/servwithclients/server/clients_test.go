@@ -0,0 +1,148 @@
+package server
+
+import (
+	"errors"
+	"testing"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientPool(t *testing.T) {
+	t.Parallel()
+
+	created := []string{}
+	fail := false
+	pool, err := newClientPool(
+		func(sub string) (string, error) {
+			if fail {
+				return "", errors.New("error")
+			}
+			created = append(created, sub)
+			return "client-" + sub, nil
+		},
+		[]string{"A", "b", "c"},
+	)
+	if err != nil {
+		t.Fatalf("TestClientPool: newClientPool() got err == %s, want err == nil", err)
+	}
+	pool.size = 2
+
+	steps := []struct {
+		name     string
+		sub      string
+		fail     bool
+		want     string
+		wantCode codes.Code
+	}{
+		{name: "create a", sub: "a", want: "client-a"},
+		{name: "reuse a, case insensitive", sub: "A", want: "client-a"},
+		{name: "create b", sub: "b", want: "client-b"},
+		{name: "use a so b is least recently used", sub: "a", want: "client-a"},
+		{name: "create c, evicting b", sub: "c", want: "client-c"},
+		{name: "a was kept", sub: "a", want: "client-a"},
+		{name: "b is created again", sub: "b", want: "client-b"},
+		{name: "not allowed", sub: "d", wantCode: codes.PermissionDenied},
+		{name: "constructor error is not cached", sub: "c", fail: true, wantCode: codes.Internal},
+	}
+
+	for _, step := range steps {
+		fail = step.fail
+		got, err := pool.get(step.sub)
+		if status.Code(err) != step.wantCode {
+			t.Errorf("TestClientPool(%s): got code %v, want %v", step.name, status.Code(err), step.wantCode)
+			continue
+		}
+		if got != step.want {
+			t.Errorf("TestClientPool(%s): got %q, want %q", step.name, got, step.want)
+		}
+	}
+
+	if diff := cmp.Diff([]string{"a", "b", "c", "b"}, created); diff != "" {
+		t.Errorf("TestClientPool: created clients -want/+got:\n%s", diff)
+	}
+}
+
+func TestNewClientPool(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(string) (string, error) { return "", nil }
+
+	tests := []struct {
+		name      string
+		newClient func(string) (string, error)
+		allowed   []string
+		wantErr   bool
+	}{
+		{name: "Error: no constructor", allowed: []string{"a"}, wantErr: true},
+		{name: "Error: no subscriptions", newClient: newClient, wantErr: true},
+		{name: "Error: empty subscription", newClient: newClient, allowed: []string{"a", " "}, wantErr: true},
+		{name: "Success", newClient: newClient, allowed: []string{"a", "b"}},
+	}
+
+	for _, test := range tests {
+		_, err := newClientPool(test.newClient, test.allowed)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestNewClientPool(%s): got err == nil, want err != nil", test.name)
+		case err != nil && !test.wantErr:
+			t.Errorf("TestNewClientPool(%s): got err == %s, want err == nil", test.name, err)
+		}
+	}
+}
+
+func TestSubscriptionOptions(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(sub string) (*armresources.ResourceGroupsClient, error) {
+		return armresources.NewResourceGroupsClient(sub, &azfake.TokenCredential{}, nil)
+	}
+	newFactory := func(sub string) (*armresources.ClientFactory, error) {
+		return armresources.NewClientFactory(sub, &azfake.TokenCredential{}, nil)
+	}
+
+	tests := []struct {
+		name            string
+		options         []Option
+		wantErr         bool
+		wantGenericCode codes.Code
+	}{
+		{
+			name:    "Error: both options",
+			options: []Option{WithSubscriptions(newClient, "other"), WithSubscriptionFactories(newFactory, "other")},
+			wantErr: true,
+		},
+		{
+			name:            "WithSubscriptions only has resource group clients",
+			options:         []Option{WithSubscriptions(newClient, "other")},
+			wantGenericCode: codes.Unimplemented,
+		},
+		{
+			name:    "WithSubscriptionFactories has all clients",
+			options: []Option{WithSubscriptionFactories(newFactory, "other")},
+		},
+	}
+
+	for _, test := range tests {
+		s, err := New(&fakeGreeter{}, &armresources.ResourceGroupsClient{}, test.options...)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestSubscriptionOptions(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestSubscriptionOptions(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		if _, err := s.clientFor("other"); err != nil {
+			t.Errorf("TestSubscriptionOptions(%s): clientFor() got err == %s, want err == nil", test.name, err)
+		}
+		if _, err := s.genericClientFor("other"); status.Code(err) != test.wantGenericCode {
+			t.Errorf("TestSubscriptionOptions(%s): genericClientFor() got code %v, want %v", test.name, status.Code(err), test.wantGenericCode)
+		}
+	}
+}
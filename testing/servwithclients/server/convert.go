@@ -0,0 +1,76 @@
+package server
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// toPBResourceGroup converts an armresources.ResourceGroup to the proto representation.
+func toPBResourceGroup(g armresources.ResourceGroup) *pb.ResourceGroup {
+	rg := &pb.ResourceGroup{
+		Id:        deref(g.ID),
+		Name:      deref(g.Name),
+		Region:    deref(g.Location),
+		ManagedBy: deref(g.ManagedBy),
+		Tags:      toPBTags(g.Tags),
+	}
+	if g.Properties != nil {
+		rg.ProvisioningState = deref(g.Properties.ProvisioningState)
+	}
+	return rg
+}
+
+// toPBTags converts Azure tags, which can have nil values, to a proto map.
+func toPBTags(tags map[string]*string) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for k, v := range tags {
+		m[k] = deref(v)
+	}
+	return m
+}
+
+// toAzTags converts proto tags to the representation used by the Azure SDK.
+func toAzTags(tags map[string]string) map[string]*string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]*string, len(tags))
+	for k, v := range tags {
+		v := v
+		m[k] = &v
+	}
+	return m
+}
+
+// armErrors flattens an error reported by ARM in the result of a deployment and its details.
+func armErrors(e *armresources.ErrorResponse) []*pb.ARMError {
+	if e == nil {
+		return nil
+	}
+	out := []*pb.ARMError{{Code: deref(e.Code), Message: deref(e.Message), Target: deref(e.Target)}}
+	for _, d := range e.Details {
+		out = append(out, armErrors(d)...)
+	}
+	return out
+}
+
+// optional returns a pointer to s, or nil if s is empty so that it is not sent to ARM.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// deref returns the value pointed to by p or the zero value if p is nil.
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,552 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// deploymentsPath separates the resource group from the deployment name in the target of a deployment operation.
+const deploymentsPath = "/providers/Microsoft.Resources/deployments/"
+
+// DeployTemplate starts deploying an ARM template to a resource group.
+func (s *Server) DeployTemplate(ctx context.Context, in *pb.DeployTemplateRequest) (*pb.DeployTemplateReply, error) {
+	client, rg, props, err := s.deploymentRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := client.BeginCreateOrUpdate(ctx, rg.name, in.GetName(), armresources.Deployment{Properties: props}, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opDeployTemplate, deploymentTarget(rg, in.GetName()), deployPoller(poll))
+
+	return &pb.DeployTemplateReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// ValidateDeployment starts checking that ARM would accept a deployment of an ARM template to a resource group.
+// If ARM rejects the deployment, the operation succeeds with a result that is not Valid and has ARM's errors.
+func (s *Server) ValidateDeployment(ctx context.Context, in *pb.DeployTemplateRequest) (*pb.ValidateDeploymentReply, error) {
+	client, rg, props, err := s.deploymentRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// ARM rejects a deployment with a 400 that has the reasons, which BeginValidate() returns as an error
+	// without its body.
+	var raw *http.Response
+	poll, err := client.BeginValidate(policy.WithCaptureResponse(ctx, &raw), rg.name, in.GetName(), armresources.Deployment{Properties: props}, nil)
+	var p lroPoller
+	switch res, rejected := rejectedValidation(raw); {
+	case err == nil:
+		p = validatePoller(poll)
+	case rejected:
+		p = &validationPoller{rejected: res}
+	default:
+		return nil, azError(err)
+	}
+	op := s.ops.start(opValidateDeployment, deploymentTarget(rg, in.GetName()), p)
+
+	return &pb.ValidateDeploymentReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// WhatIfDeployment starts predicting the changes a deployment of an ARM template to a resource group would make.
+func (s *Server) WhatIfDeployment(ctx context.Context, in *pb.DeployTemplateRequest) (*pb.WhatIfDeploymentReply, error) {
+	client, rg, props, err := s.deploymentRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	params := armresources.DeploymentWhatIf{
+		Properties: &armresources.DeploymentWhatIfProperties{
+			Mode:         props.Mode,
+			Template:     props.Template,
+			TemplateLink: props.TemplateLink,
+			Parameters:   props.Parameters,
+		},
+	}
+	poll, err := client.BeginWhatIf(ctx, rg.name, in.GetName(), params, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opWhatIfDeployment, deploymentTarget(rg, in.GetName()), whatIfPoller(poll))
+
+	return &pb.WhatIfDeploymentReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// GetDeployment reads a deployment in a resource group.
+func (s *Server) GetDeployment(ctx context.Context, in *pb.GetDeploymentRequest) (*pb.Deployment, error) {
+	rg, err := parseRGRequest("ResourceGroup", in.GetResourceGroup(), in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+	v := violations{}
+	validateDeploymentName(&v, "Name", in.GetName())
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	client, err := s.deploymentsClientFor(rg.subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, rg.name, in.GetName(), nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	d, err := toPBDeployment(resp.DeploymentExtended)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return d, nil
+}
+
+// ListDeployments lists the deployments in a resource group.
+func (s *Server) ListDeployments(ctx context.Context, in *pb.ListDeploymentsRequest) (*pb.ListDeploymentsReply, error) {
+	size, err := pageSize(in.GetPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rg, err := parseRGRequest("ResourceGroup", in.GetResourceGroup(), in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+	tok, err := s.requestPageToken(in.GetPageToken(), scopeHash(rg.id()+deploymentsPath))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.deploymentsClientFor(rg.subscription)
+	if err != nil {
+		return nil, err
+	}
+	page := func(p armresources.DeploymentsClientListByResourceGroupResponse) ([]*armresources.DeploymentExtended, *string) {
+		return p.Value, p.NextLink
+	}
+	items, next, err := readPage(ctx, client.NewListByResourceGroupPager(rg.name, nil), tok, size, page)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListDeploymentsReply{Deployments: make([]*pb.Deployment, 0, len(items))}
+	for _, d := range items {
+		pd, err := toPBDeployment(*d)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reply.Deployments = append(reply.Deployments, pd)
+	}
+	if reply.NextPageToken, err = s.nextPageToken(next); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// deploymentRequest validates a DeployTemplateRequest and converts it to the properties of a deployment.
+// It returns the client for the subscription of the resource group.
+func (s *Server) deploymentRequest(in *pb.DeployTemplateRequest) (deploymentsClient, rgRef, *armresources.DeploymentProperties, error) {
+	rg, err := parseRGRequest("ResourceGroup", in.GetResourceGroup(), in.GetSubscription())
+	if err != nil {
+		return nil, rgRef{}, nil, err
+	}
+
+	v := violations{}
+	validateDeploymentName(&v, "Name", in.GetName())
+	props := &armresources.DeploymentProperties{Mode: toAzDeploymentMode(in.GetMode())}
+	if props.Mode == nil {
+		v.add("Mode", "unknown DeploymentMode %v", in.GetMode())
+	}
+	switch {
+	case in.GetTemplate() == "" && in.GetTemplateUri() == "":
+		v.add("Template", "one of Template or TemplateUri is required")
+	case in.GetTemplate() != "" && in.GetTemplateUri() != "":
+		v.add("TemplateUri", "cannot be set with Template")
+	case in.GetTemplate() != "":
+		var t map[string]any
+		switch err := json.Unmarshal([]byte(in.GetTemplate()), &t); {
+		case err != nil:
+			v.add("Template", "is not a valid JSON object: %s", err)
+		case t == nil:
+			v.add("Template", "must be a JSON object, not null")
+		}
+		props.Template = t
+	default:
+		u, err := url.Parse(in.GetTemplateUri())
+		if err != nil || !u.IsAbs() || (u.Scheme != "https" && u.Scheme != "http") {
+			v.add("TemplateUri", "must be an absolute http or https URI")
+		}
+		props.TemplateLink = &armresources.TemplateLink{URI: optional(in.GetTemplateUri())}
+	}
+	if params := parseDeploymentParameters(&v, "Parameters", in.GetParameters()); params != nil {
+		props.Parameters = params
+	}
+	if err := v.err(); err != nil {
+		return nil, rgRef{}, nil, err
+	}
+
+	client, err := s.deploymentsClientFor(rg.subscription)
+	if err != nil {
+		return nil, rgRef{}, nil, err
+	}
+	return client, rg, props, nil
+}
+
+// parseDeploymentParameters decodes the JSON template parameters given in field. They can be a map of
+// parameter names to values or a parameters file, which holds that map in its "parameters" key.
+// Empty parameters are nil.
+func parseDeploymentParameters(v *violations, field, params string) map[string]any {
+	if params == "" {
+		return nil
+	}
+	var p map[string]any
+	if err := json.Unmarshal([]byte(params), &p); err != nil {
+		v.add(field, "is not a valid JSON object: %s", err)
+		return nil
+	}
+	if _, ok := p["$schema"]; !ok {
+		return p
+	}
+	inner, ok := p["parameters"].(map[string]any)
+	if !ok {
+		v.add(field, `is a parameters file without a "parameters" object`)
+		return nil
+	}
+	return inner
+}
+
+// deploymentTarget is the target of an operation on the deployment called name in rg.
+func deploymentTarget(rg rgRef, name string) string {
+	return rg.id() + deploymentsPath + name
+}
+
+// toAzDeploymentMode converts m to ARM's deployment mode. DEPLOYMENT_MODE_UNSPECIFIED is ARM's default,
+// incremental. An unknown m is nil.
+func toAzDeploymentMode(m pb.DeploymentMode) *armresources.DeploymentMode {
+	var mode armresources.DeploymentMode
+	switch m {
+	case pb.DeploymentMode_DEPLOYMENT_MODE_UNSPECIFIED, pb.DeploymentMode_DEPLOYMENT_MODE_INCREMENTAL:
+		mode = armresources.DeploymentModeIncremental
+	case pb.DeploymentMode_DEPLOYMENT_MODE_COMPLETE:
+		mode = armresources.DeploymentModeComplete
+	default:
+		return nil
+	}
+	return &mode
+}
+
+// toPBDeploymentMode converts ARM's deployment mode to the proto representation. A missing or unknown mode is
+// DEPLOYMENT_MODE_UNSPECIFIED.
+func toPBDeploymentMode(m *armresources.DeploymentMode) pb.DeploymentMode {
+	switch {
+	case m == nil:
+		return pb.DeploymentMode_DEPLOYMENT_MODE_UNSPECIFIED
+	case strings.EqualFold(string(*m), string(armresources.DeploymentModeIncremental)):
+		return pb.DeploymentMode_DEPLOYMENT_MODE_INCREMENTAL
+	case strings.EqualFold(string(*m), string(armresources.DeploymentModeComplete)):
+		return pb.DeploymentMode_DEPLOYMENT_MODE_COMPLETE
+	}
+	return pb.DeploymentMode_DEPLOYMENT_MODE_UNSPECIFIED
+}
+
+// toPBDeployment converts an armresources.DeploymentExtended to the proto representation.
+func toPBDeployment(d armresources.DeploymentExtended) (*pb.Deployment, error) {
+	pd := &pb.Deployment{Id: deref(d.ID), Name: deref(d.Name)}
+	p := d.Properties
+	if p == nil {
+		return pd, nil
+	}
+
+	pd.ProvisioningState = string(deref(p.ProvisioningState))
+	pd.Mode = toPBDeploymentMode(p.Mode)
+	pd.CorrelationId = deref(p.CorrelationID)
+	pd.OutputResources = resourceRefIDs(p.OutputResources)
+	pd.Errors = armErrors(p.Error)
+	if p.Timestamp != nil {
+		pd.Timestamp = timestamppb.New(*p.Timestamp)
+	}
+	if d, ok := parseISODuration(deref(p.Duration)); ok {
+		pd.Duration = durationpb.New(d)
+	}
+	var err error
+	if pd.Outputs, err = jsonString(p.Outputs); err != nil {
+		return nil, fmt.Errorf("could not marshal outputs: %w", err)
+	}
+	return pd, nil
+}
+
+// resourceRefIDs returns the IDs in refs.
+func resourceRefIDs(refs []*armresources.ResourceReference) []string {
+	var ids []string
+	for _, r := range refs {
+		if r != nil && r.ID != nil {
+			ids = append(ids, *r.ID)
+		}
+	}
+	return ids
+}
+
+// jsonString marshals v to JSON. A nil v is the empty string.
+func jsonString(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// isoDurationRE matches the ISO 8601 durations ARM reports, such as "PT1M30.5S" or "P1DT2H".
+var isoDurationRE = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseISODuration parses an ISO 8601 duration of days, hours, minutes and seconds.
+func parseISODuration(s string) (time.Duration, bool) {
+	m := isoDurationRE.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "PT" {
+		return 0, false
+	}
+	var d time.Duration
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	for i, u := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(m[i+1], 64)
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(f * float64(u))
+	}
+	return d, true
+}
+
+// deployPoller adapts the poller for a deployment so that its result is a Deployment.
+func deployPoller(poll *runtime.Poller[armresources.DeploymentsClientCreateOrUpdateResponse]) lroPoller {
+	convert := func(r armresources.DeploymentsClientCreateOrUpdateResponse) (*anypb.Any, error) {
+		d, err := toPBDeployment(r.DeploymentExtended)
+		if err != nil {
+			return nil, err
+		}
+		return anypb.New(d)
+	}
+	return armPoller[armresources.DeploymentsClientCreateOrUpdateResponse]{Poller: poll, convert: convert}
+}
+
+// validatePoller adapts the poller for a validation so that its result is a ValidateDeploymentResult.
+func validatePoller(poll *runtime.Poller[armresources.DeploymentsClientValidateResponse]) lroPoller {
+	convert := func(r armresources.DeploymentsClientValidateResponse) (*anypb.Any, error) {
+		res := &pb.ValidateDeploymentResult{Valid: r.Error == nil, Errors: armErrors(r.Error)}
+		if r.Properties != nil {
+			res.ValidatedResources = resourceRefIDs(r.Properties.ValidatedResources)
+		}
+		return anypb.New(res)
+	}
+	return &validationPoller{armPoller: armPoller[armresources.DeploymentsClientValidateResponse]{Poller: poll, convert: convert}}
+}
+
+// validationPoller is the lroPoller of a validation. A validation that ARM rejects fails with a 400 that
+// has the reasons, which validationPoller turns into a result that is not Valid instead of an error.
+type validationPoller struct {
+	armPoller[armresources.DeploymentsClientValidateResponse]
+
+	// rejected is the result once ARM has rejected the deployment. If it is set when the validation
+	// starts, ARM rejected it right away and there is no poller.
+	rejected *pb.ValidateDeploymentResult
+}
+
+func (v *validationPoller) Done() bool {
+	return v.rejected != nil || v.armPoller.Done()
+}
+
+func (v *validationPoller) Poll(ctx context.Context) (*http.Response, error) {
+	resp, err := v.armPoller.Poll(ctx)
+	if res, ok := rejectedValidationErr(err); ok {
+		v.rejected = res
+		return resp, nil
+	}
+	return resp, err
+}
+
+func (v *validationPoller) ResumeToken() (string, error) {
+	if v.Poller == nil {
+		return "", errors.New("validation was rejected when it started")
+	}
+	return v.armPoller.ResumeToken()
+}
+
+func (v *validationPoller) result(ctx context.Context) (*anypb.Any, error) {
+	if v.rejected != nil {
+		return anypb.New(v.rejected)
+	}
+	res, err := v.armPoller.result(ctx)
+	if rejected, ok := rejectedValidationErr(err); ok {
+		return anypb.New(rejected)
+	}
+	return res, err
+}
+
+// rejectedValidationErr is rejectedValidation() for the response of err, if it is an *azcore.ResponseError.
+func rejectedValidationErr(err error) (*pb.ValidateDeploymentResult, bool) {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		return nil, false
+	}
+	return rejectedValidation(respErr.RawResponse)
+}
+
+// rejectedValidation returns the result of a validation that ARM rejected with resp, which is a 400 with a
+// DeploymentValidateResult that has the errors. It returns false if resp is anything else.
+func rejectedValidation(resp *http.Response) (*pb.ValidateDeploymentResult, bool) {
+	if resp == nil || resp.StatusCode != http.StatusBadRequest {
+		return nil, false
+	}
+	body, err := runtime.Payload(resp)
+	if err != nil {
+		return nil, false
+	}
+	var r armresources.DeploymentValidateResult
+	if err := json.Unmarshal(body, &r); err != nil || r.Error == nil {
+		return nil, false
+	}
+	return &pb.ValidateDeploymentResult{Errors: armErrors(r.Error)}, true
+}
+
+// whatIfPoller adapts the poller for a What-If so that its result is a WhatIfResult.
+func whatIfPoller(poll *runtime.Poller[armresources.DeploymentsClientWhatIfResponse]) lroPoller {
+	convert := func(r armresources.DeploymentsClientWhatIfResponse) (*anypb.Any, error) {
+		res, err := toPBWhatIfResult(r.WhatIfOperationResult)
+		if err != nil {
+			return nil, err
+		}
+		return anypb.New(res)
+	}
+	return armPoller[armresources.DeploymentsClientWhatIfResponse]{Poller: poll, convert: convert}
+}
+
+// toPBWhatIfResult converts an armresources.WhatIfOperationResult to the proto representation.
+func toPBWhatIfResult(r armresources.WhatIfOperationResult) (*pb.WhatIfResult, error) {
+	res := &pb.WhatIfResult{Status: deref(r.Status), Errors: armErrors(r.Error)}
+	if r.Properties == nil {
+		return res, nil
+	}
+	for _, c := range r.Properties.Changes {
+		if c == nil {
+			continue
+		}
+		pc := &pb.WhatIfChange{
+			ResourceId:        deref(c.ResourceID),
+			ChangeType:        string(deref(c.ChangeType)),
+			UnsupportedReason: deref(c.UnsupportedReason),
+		}
+		var err error
+		if pc.Before, err = jsonString(c.Before); err != nil {
+			return nil, fmt.Errorf("could not marshal resource before change: %w", err)
+		}
+		if pc.After, err = jsonString(c.After); err != nil {
+			return nil, fmt.Errorf("could not marshal resource after change: %w", err)
+		}
+		if pc.Delta, err = propertyChanges("", c.Delta); err != nil {
+			return nil, err
+		}
+		res.Changes = append(res.Changes, pc)
+	}
+	return res, nil
+}
+
+// propertyChanges flattens changes and their children. prefix is the path of the parent property, if any.
+func propertyChanges(prefix string, changes []*armresources.WhatIfPropertyChange) ([]*pb.PropertyChange, error) {
+	var out []*pb.PropertyChange
+	for _, c := range changes {
+		if c == nil {
+			continue
+		}
+		path := deref(c.Path)
+		switch {
+		case prefix == "":
+		case strings.HasPrefix(path, "["):
+			path = prefix + path
+		default:
+			path = prefix + "." + path
+		}
+
+		if len(c.Children) > 0 {
+			children, err := propertyChanges(path, c.Children)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, children...)
+			continue
+		}
+
+		pc := &pb.PropertyChange{Path: path, ChangeType: string(deref(c.PropertyChangeType))}
+		var err error
+		if pc.Before, err = jsonString(c.Before); err != nil {
+			return nil, fmt.Errorf("could not marshal %s before change: %w", path, err)
+		}
+		if pc.After, err = jsonString(c.After); err != nil {
+			return nil, fmt.Errorf("could not marshal %s after change: %w", path, err)
+		}
+		out = append(out, pc)
+	}
+	return out, nil
+}
+
+// resumeDeploymentPoller recreates the poller for a deployment operation of kind from its resume token.
+func (s *Server) resumeDeploymentPoller(kind, target, token string) (lroPoller, error) {
+	group, name, ok := strings.Cut(target, deploymentsPath)
+	if !ok {
+		return nil, fmt.Errorf("operation target %q is not a deployment", target)
+	}
+	rg, err := parseRGRequest("Target", group, "")
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.deploymentsClientFor(rg.subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	switch kind {
+	case opDeployTemplate:
+		poll, err := client.BeginCreateOrUpdate(ctx, rg.name, name, armresources.Deployment{}, &armresources.DeploymentsClientBeginCreateOrUpdateOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return deployPoller(poll), nil
+	case opValidateDeployment:
+		poll, err := client.BeginValidate(ctx, rg.name, name, armresources.Deployment{}, &armresources.DeploymentsClientBeginValidateOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return validatePoller(poll), nil
+	case opWhatIfDeployment:
+		poll, err := client.BeginWhatIf(ctx, rg.name, name, armresources.DeploymentWhatIf{}, &armresources.DeploymentsClientBeginWhatIfOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return whatIfPoller(poll), nil
+	}
+	return nil, fmt.Errorf("unknown operation kind %q", kind)
+}
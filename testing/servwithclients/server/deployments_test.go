@@ -0,0 +1,598 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources/fake"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func mustFakeDeploymentsClient(calls *fakeDeploymentsCalls) deploymentsClient {
+	fs := newDeploymentsServer(calls)
+	client, err := armresources.NewDeploymentsClient(
+		"subscriptionID",
+		&azfake.TokenCredential{},
+		&arm.ClientOptions{
+			ClientOptions: azcore.ClientOptions{
+				Transport: fake.NewDeploymentsServerTransport(&fs),
+			},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+const deploymentID = "/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Resources/deployments/deploy"
+
+const template = `{"$schema":"https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#","contentVersion":"1.0.0.0","resources":[]}`
+
+// stubResponse is a response returned by a stubTransport.
+type stubResponse struct {
+	status int
+	header http.Header
+	body   string
+}
+
+// stubTransport is a policy.Transporter that returns its responses in order. It is used where the fakes can't
+// send what ARM does, such as an error response with a body.
+type stubTransport struct {
+	mu    sync.Mutex
+	resps []stubResponse
+}
+
+func (s *stubTransport) Do(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.resps) == 0 {
+		return nil, fmt.Errorf("no response for %s %s", req.Method, req.URL)
+	}
+	r := s.resps[0]
+	s.resps = s.resps[1:]
+	header := r.header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		StatusCode: r.status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(r.body)),
+		Request:    req,
+	}, nil
+}
+
+// mustStubDeploymentsClient returns a deploymentsClient that gets resps, in order, for its requests.
+func mustStubDeploymentsClient(resps ...stubResponse) deploymentsClient {
+	client, err := armresources.NewDeploymentsClient(
+		"subscriptionID",
+		&azfake.TokenCredential{},
+		&arm.ClientOptions{
+			ClientOptions: azcore.ClientOptions{
+				Transport: &stubTransport{resps: resps},
+				Retry:     policy.RetryOptions{MaxRetries: -1},
+			},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// waitResult waits for the operation id to finish, checks its state, kind and target, and unmarshals its
+// result into result if it succeeded.
+func waitResult(t *testing.T, s *Server, id string, wantState pb.OperationState, wantKind string, result proto.Message) bool {
+	t.Helper()
+
+	op, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: id})
+	if err != nil {
+		t.Errorf("WaitOperation(): got err == %s, want err == nil", err)
+		return false
+	}
+	if op.State != wantState {
+		t.Errorf("got state %v, want %v: %s", op.State, wantState, op.ErrorMessage)
+		return false
+	}
+	if op.Kind != wantKind || op.Target != "rg"+deploymentsPath+"deploy" {
+		t.Errorf("got operation %s on %s, want %s on %s", op.Kind, op.Target, wantKind, "rg"+deploymentsPath+"deploy")
+	}
+	if wantState != pb.OperationState_OPERATION_STATE_SUCCEEDED {
+		return false
+	}
+	if err := op.Result.UnmarshalTo(result); err != nil {
+		t.Errorf("could not unmarshal result: %s", err)
+		return false
+	}
+	return true
+}
+
+func TestDeployTemplate(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	deployed := armresources.DeploymentsClientCreateOrUpdateResponse{
+		DeploymentExtended: armresources.DeploymentExtended{
+			ID:   toPtr(deploymentID),
+			Name: toPtr("deploy"),
+			Properties: &armresources.DeploymentPropertiesExtended{
+				ProvisioningState: toPtr(armresources.ProvisioningStateSucceeded),
+				Mode:              toPtr(armresources.DeploymentModeComplete),
+				Timestamp:         &ts,
+				Duration:          toPtr("PT1M30.5S"),
+				CorrelationID:     toPtr("correlation"),
+				Outputs:           map[string]any{"name": map[string]any{"type": "String", "value": "vnet"}},
+				OutputResources:   []*armresources.ResourceReference{{ID: toPtr(vnetID)}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		req        *pb.DeployTemplateRequest
+		fakeCalls  *fakeDeploymentsCalls
+		noClient   bool
+		wantCode   codes.Code
+		wantState  pb.OperationState
+		wantParams armresources.Deployment
+		want       *pb.Deployment
+	}{
+		{
+			name:      "Error: invalid deployment name",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "bad name!", Template: template},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: no template",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy"},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: Template is not an object",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: "null"},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: Template and TemplateUri",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template, TemplateUri: "https://example.com/t.json"},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: relative TemplateUri",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", TemplateUri: "t.json"},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: invalid parameters",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template, Parameters: "[]"},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: unknown mode",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template, Mode: pb.DeploymentMode(100)},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:     "Error: no deployments client",
+			req:      &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template},
+			noClient: true,
+			wantCode: codes.Unimplemented,
+		},
+		{
+			// ARM accepts a deployment with 201, not the 202 the fake poller sends for non-terminal
+			// responses, so the error is the first response and BeginCreateOrUpdate() returns it.
+			name:      "Error: deployment rejected",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template},
+			fakeCalls: &fakeDeploymentsCalls{beginCreateOrUpdate: []any{errors.New("error")}},
+			wantCode:  codes.FailedPrecondition,
+		},
+		{
+			name: "Success: TemplateUri with a parameters file",
+			req: &pb.DeployTemplateRequest{
+				ResourceGroup: "rg",
+				Name:          "deploy",
+				TemplateUri:   "https://example.com/t.json",
+				Parameters:    `{"$schema":"https://schema.management.azure.com/schemas/2019-04-01/deploymentParameters.json#","parameters":{"name":{"value":"vnet"}}}`,
+				Mode:          pb.DeploymentMode_DEPLOYMENT_MODE_COMPLETE,
+			},
+			fakeCalls: &fakeDeploymentsCalls{beginCreateOrUpdate: []any{deployed}},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+			wantParams: armresources.Deployment{
+				Properties: &armresources.DeploymentProperties{
+					Mode:         toPtr(armresources.DeploymentModeComplete),
+					TemplateLink: &armresources.TemplateLink{URI: toPtr("https://example.com/t.json")},
+					Parameters:   map[string]any{"name": map[string]any{"value": "vnet"}},
+				},
+			},
+			want: &pb.Deployment{
+				Id:                deploymentID,
+				Name:              "deploy",
+				ProvisioningState: "Succeeded",
+				Mode:              pb.DeploymentMode_DEPLOYMENT_MODE_COMPLETE,
+				Timestamp:         timestamppb.New(ts),
+				Duration:          durationpb.New(90*time.Second + 500*time.Millisecond),
+				CorrelationId:     "correlation",
+				Outputs:           `{"name":{"type":"String","value":"vnet"}}`,
+				OutputResources:   []string{vnetID},
+			},
+		},
+		{
+			name:      "Success: Template",
+			req:       &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template},
+			fakeCalls: &fakeDeploymentsCalls{beginCreateOrUpdate: []any{deployed}},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+			wantParams: armresources.Deployment{
+				Properties: &armresources.DeploymentProperties{
+					Mode: toPtr(armresources.DeploymentModeIncremental),
+					Template: map[string]any{
+						"$schema":        "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+						"contentVersion": "1.0.0.0",
+						"resources":      []any{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{ops: operations{pollFrequency: time.Millisecond}}
+		if !test.noClient {
+			s.deploymentsClient = mustFakeDeploymentsClient(test.fakeCalls)
+		}
+		resp, err := s.DeployTemplate(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestDeployTemplate(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		got := &pb.Deployment{}
+		if !waitResult(t, s, resp.OperationId, test.wantState, opDeployTemplate, got) {
+			continue
+		}
+		if diff := cmp.Diff(test.wantParams, test.fakeCalls.gotDeployments[0]); diff != "" {
+			t.Errorf("TestDeployTemplate(%s): parameters -want/+got:\n%s", test.name, diff)
+		}
+		if test.want == nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestDeployTemplate(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestValidateDeployment(t *testing.T) {
+	t.Parallel()
+
+	// rejected is the body ARM rejects a deployment with.
+	const rejected = `{"error":{"code":"InvalidTemplate","message":"template is invalid","details":[{"code":"MissingParameter","target":"name"}]}}`
+	wantRejected := &pb.ValidateDeploymentResult{
+		Errors: []*pb.ARMError{
+			{Code: "InvalidTemplate", Message: "template is invalid"},
+			{Code: "MissingParameter", Target: "name"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeDeploymentsCalls
+		// client, if set, is used instead of a fake built from fakeCalls.
+		client deploymentsClient
+		want   *pb.ValidateDeploymentResult
+	}{
+		{
+			name: "Valid",
+			fakeCalls: &fakeDeploymentsCalls{beginValidate: []any{
+				armresources.DeploymentsClientValidateResponse{
+					DeploymentValidateResult: armresources.DeploymentValidateResult{
+						Properties: &armresources.DeploymentPropertiesExtended{
+							ValidatedResources: []*armresources.ResourceReference{{ID: toPtr(vnetID)}},
+						},
+					},
+				},
+			}},
+			want: &pb.ValidateDeploymentResult{Valid: true, ValidatedResources: []string{vnetID}},
+		},
+		{
+			name: "Invalid",
+			fakeCalls: &fakeDeploymentsCalls{beginValidate: []any{
+				armresources.DeploymentsClientValidateResponse{
+					DeploymentValidateResult: armresources.DeploymentValidateResult{
+						Error: &armresources.ErrorResponse{
+							Code:    toPtr("InvalidTemplate"),
+							Message: toPtr("template is invalid"),
+							Details: []*armresources.ErrorResponse{{Code: toPtr("MissingParameter"), Target: toPtr("name")}},
+						},
+					},
+				},
+			}},
+			want: wantRejected,
+		},
+		{
+			name:   "Rejected when started",
+			client: mustStubDeploymentsClient(stubResponse{status: http.StatusBadRequest, body: rejected}),
+			want:   wantRejected,
+		},
+		{
+			name: "Rejected while polling",
+			client: mustStubDeploymentsClient(
+				stubResponse{status: http.StatusAccepted, header: http.Header{"Location": {"https://management.azure.com/operationResults/1"}}},
+				stubResponse{status: http.StatusBadRequest, body: rejected},
+			),
+			want: wantRejected,
+		},
+	}
+
+	for _, test := range tests {
+		client := test.client
+		if client == nil {
+			client = mustFakeDeploymentsClient(test.fakeCalls)
+		}
+		s := &Server{deploymentsClient: client, ops: operations{pollFrequency: time.Millisecond}}
+		resp, err := s.ValidateDeployment(context.Background(), &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template})
+		if err != nil {
+			t.Errorf("TestValidateDeployment(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		}
+
+		got := &pb.ValidateDeploymentResult{}
+		if !waitResult(t, s, resp.OperationId, pb.OperationState_OPERATION_STATE_SUCCEEDED, opValidateDeployment, got) {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestValidateDeployment(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestWhatIfDeployment(t *testing.T) {
+	t.Parallel()
+
+	result := armresources.DeploymentsClientWhatIfResponse{
+		WhatIfOperationResult: armresources.WhatIfOperationResult{
+			Status: toPtr("Succeeded"),
+			Properties: &armresources.WhatIfOperationProperties{
+				Changes: []*armresources.WhatIfChange{
+					{
+						ResourceID: toPtr(vnetID),
+						ChangeType: toPtr(armresources.ChangeTypeModify),
+						Before:     map[string]any{"name": "vnet"},
+						After:      map[string]any{"name": "vnet"},
+						Delta: []*armresources.WhatIfPropertyChange{
+							{
+								Path:               toPtr("properties.addressSpace.addressPrefixes"),
+								PropertyChangeType: toPtr(armresources.PropertyChangeTypeArray),
+								Children: []*armresources.WhatIfPropertyChange{
+									{Path: toPtr("[0]"), PropertyChangeType: toPtr(armresources.PropertyChangeTypeModify), Before: "10.0.0.0/16", After: "10.1.0.0/16"},
+								},
+							},
+							{Path: toPtr("tags.owner"), PropertyChangeType: toPtr(armresources.PropertyChangeTypeCreate), After: "bob"},
+						},
+					},
+					{ResourceID: toPtr("/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/acct"), ChangeType: toPtr(armresources.ChangeTypeCreate)},
+				},
+			},
+		},
+	}
+
+	calls := &fakeDeploymentsCalls{beginWhatIf: []any{armresources.DeploymentsClientWhatIfResponse{}, result}}
+	s := &Server{deploymentsClient: mustFakeDeploymentsClient(calls), ops: operations{pollFrequency: time.Millisecond}}
+	req := &pb.DeployTemplateRequest{ResourceGroup: "rg", Name: "deploy", Template: template, Parameters: `{"name":{"value":"vnet"}}`}
+	resp, err := s.WhatIfDeployment(context.Background(), req)
+	if err != nil {
+		t.Fatalf("TestWhatIfDeployment: got err == %s, want err == nil", err)
+	}
+
+	got := &pb.WhatIfResult{}
+	if !waitResult(t, s, resp.OperationId, pb.OperationState_OPERATION_STATE_SUCCEEDED, opWhatIfDeployment, got) {
+		return
+	}
+	want := &pb.WhatIfResult{
+		Status: "Succeeded",
+		Changes: []*pb.WhatIfChange{
+			{
+				ResourceId: vnetID,
+				ChangeType: "Modify",
+				Before:     `{"name":"vnet"}`,
+				After:      `{"name":"vnet"}`,
+				Delta: []*pb.PropertyChange{
+					{Path: "properties.addressSpace.addressPrefixes[0]", ChangeType: "Modify", Before: `"10.0.0.0/16"`, After: `"10.1.0.0/16"`},
+					{Path: "tags.owner", ChangeType: "Create", After: `"bob"`},
+				},
+			},
+			{ResourceId: "/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/acct", ChangeType: "Create"},
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("TestWhatIfDeployment: -want/+got:\n%s", diff)
+	}
+	wantParams := map[string]any{"name": map[string]any{"value": "vnet"}}
+	if diff := cmp.Diff(wantParams, calls.gotWhatIfs[0].Properties.Parameters); diff != "" {
+		t.Errorf("TestWhatIfDeployment: parameters -want/+got:\n%s", diff)
+	}
+}
+
+func TestGetDeployment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		req       *pb.GetDeploymentRequest
+		fakeCalls *fakeDeploymentsCalls
+		wantCode  codes.Code
+		want      *pb.Deployment
+	}{
+		{
+			name:      "Error: no name",
+			req:       &pb.GetDeploymentRequest{ResourceGroup: "rg"},
+			fakeCalls: &fakeDeploymentsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: Get() returned an error",
+			req:       &pb.GetDeploymentRequest{ResourceGroup: "rg", Name: "deploy"},
+			fakeCalls: &fakeDeploymentsCalls{get: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name: "Success: failed deployment",
+			req:  &pb.GetDeploymentRequest{ResourceGroup: "rg", Name: "deploy"},
+			fakeCalls: &fakeDeploymentsCalls{get: []any{
+				armresources.DeploymentsClientGetResponse{
+					DeploymentExtended: armresources.DeploymentExtended{
+						ID:   toPtr(deploymentID),
+						Name: toPtr("deploy"),
+						Properties: &armresources.DeploymentPropertiesExtended{
+							ProvisioningState: toPtr(armresources.ProvisioningStateFailed),
+							Mode:              toPtr(armresources.DeploymentModeIncremental),
+							Error:             &armresources.ErrorResponse{Code: toPtr("DeploymentFailed"), Message: toPtr("failed")},
+						},
+					},
+				},
+			}},
+			want: &pb.Deployment{
+				Id:                deploymentID,
+				Name:              "deploy",
+				ProvisioningState: "Failed",
+				Mode:              pb.DeploymentMode_DEPLOYMENT_MODE_INCREMENTAL,
+				Errors:            []*pb.ARMError{{Code: "DeploymentFailed", Message: "failed"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{deploymentsClient: mustFakeDeploymentsClient(test.fakeCalls)}
+		got, err := s.GetDeployment(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestGetDeployment(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestGetDeployment(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestListDeploymentsPaging(t *testing.T) {
+	t.Parallel()
+
+	listPage := func(names ...string) armresources.DeploymentsClientListByResourceGroupResponse {
+		page := armresources.DeploymentsClientListByResourceGroupResponse{}
+		for _, n := range names {
+			page.Value = append(page.Value, &armresources.DeploymentExtended{Name: toPtr(n)})
+		}
+		return page
+	}
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeDeploymentsCalls
+		want      [][]string
+	}{
+		{
+			name:      "Page ends inside an ARM page",
+			fakeCalls: &fakeDeploymentsCalls{list: []any{listPage("a", "b", "c")}},
+			want:      [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name:      "Page ends on an ARM page boundary",
+			fakeCalls: &fakeDeploymentsCalls{list: []any{listPage("a", "b"), listPage("c", "d")}},
+			want:      [][]string{{"a", "b"}, {"c", "d"}},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{deploymentsClient: mustFakeDeploymentsClient(test.fakeCalls)}
+
+		req := &pb.ListDeploymentsRequest{ResourceGroup: "rg", PageSize: 2}
+		var got [][]string
+		for i := 0; i < 10; i++ {
+			resp, err := s.ListDeployments(context.Background(), req)
+			if err != nil {
+				t.Fatalf("TestListDeploymentsPaging(%s): got err == %s, want err == nil", test.name, err)
+			}
+			var names []string
+			for _, d := range resp.Deployments {
+				names = append(names, d.Name)
+			}
+			got = append(got, names)
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("TestListDeploymentsPaging(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+
+	// A token from ListResources cannot be used to list deployments.
+	s := &Server{deploymentsClient: mustFakeDeploymentsClient(&fakeDeploymentsCalls{})}
+	tok, err := s.encodePageToken(pageToken{Filter: scopeHash("rg")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.ListDeployments(context.Background(), &pb.ListDeploymentsRequest{ResourceGroup: "rg", PageToken: tok})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestListDeploymentsPaging(token for resources): got code %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestParseISODuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in     string
+		want   time.Duration
+		wantOK bool
+	}{
+		{in: "", wantOK: false},
+		{in: "PT", wantOK: false},
+		{in: "1h", wantOK: false},
+		{in: "PT0S", want: 0, wantOK: true},
+		{in: "PT1M30.5S", want: 90*time.Second + 500*time.Millisecond, wantOK: true},
+		{in: "P1DT2H", want: 26 * time.Hour, wantOK: true},
+	}
+
+	for _, test := range tests {
+		got, ok := parseISODuration(test.in)
+		if ok != test.wantOK || got != test.want {
+			t.Errorf("TestParseISODuration(%q): got %v, %v, want %v, %v", test.in, got, ok, test.want, test.wantOK)
+		}
+	}
+}
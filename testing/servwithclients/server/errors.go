@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// armDomain is the ErrorInfo domain used when the ARM host can't be determined from the response.
+const armDomain = "management.azure.com"
+
+// azError translates an error returned by an Azure SDK client into a gRPC status error.
+// An *azcore.ResponseError is mapped to a code based on its HTTP status and ARM error code,
+// with the ARM error code and request ID attached as an errdetails.ErrorInfo.
+// Context errors are mapped to Canceled or DeadlineExceeded and errors that already carry a
+// gRPC status are returned unchanged.
+func azError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return status.FromContextError(err).Err()
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+
+	code := httpToCode(respErr.StatusCode, respErr.ErrorCode)
+	st := status.New(code, respErrMsg(respErr))
+
+	details := []protoadapt.MessageV1{errorInfo(respErr)}
+	if respErr.StatusCode == http.StatusTooManyRequests {
+		if d, ok := retryAfter(respErr.RawResponse); ok {
+			details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(d)})
+		}
+	}
+
+	withDetails, detErr := st.WithDetails(details...)
+	if detErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// httpToCode maps an HTTP status code and ARM error code to a gRPC code.
+func httpToCode(httpStatus int, armCode string) codes.Code {
+	switch {
+	case httpStatus == http.StatusBadRequest:
+		return codes.InvalidArgument
+	case httpStatus == http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case httpStatus == http.StatusForbidden:
+		return codes.PermissionDenied
+	case httpStatus == http.StatusNotFound:
+		return codes.NotFound
+	case httpStatus == http.StatusConflict:
+		if isExistsCode(armCode) {
+			return codes.AlreadyExists
+		}
+		return codes.FailedPrecondition
+	case httpStatus == http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case httpStatus == http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case httpStatus == http.StatusNotImplemented:
+		return codes.Unimplemented
+	case httpStatus == http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case httpStatus >= 500:
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+// isExistsCode determines if an ARM error code returned with a 409 indicates the resource already exists.
+// Other conflicts, such as a group that is being deleted or a scope lock, are precondition failures.
+func isExistsCode(armCode string) bool {
+	switch {
+	case strings.HasSuffix(armCode, "Exists"):
+		// ResourceGroupExists, ResourceAlreadyExists, ...
+		return true
+	case armCode == "InvalidResourceGroupLocation":
+		// Returned when creating a group that already exists in another location.
+		return true
+	}
+	return false
+}
+
+// respErrMsg is the message of the gRPC status for respErr. It has the HTTP status, the ARM error code and
+// the message ARM gave for the error.
+func respErrMsg(respErr *azcore.ResponseError) string {
+	msg := fmt.Sprintf("ARM returned HTTP %d", respErr.StatusCode)
+	if respErr.ErrorCode != "" {
+		msg += ": " + respErr.ErrorCode
+	}
+	if m := armErrorMessage(respErr.RawResponse); m != "" {
+		msg += ": " + m
+	}
+	return msg
+}
+
+// armErrorMessage returns the message in the error body of resp. ARM wraps the error in an "error" object,
+// but some resource providers return it bare. If the body has no message, it returns "".
+func armErrorMessage(resp *http.Response) string {
+	if resp == nil || resp.Body == nil {
+		return ""
+	}
+	body, err := runtime.Payload(resp)
+	if err != nil || len(body) == 0 {
+		return ""
+	}
+	type armError struct {
+		Message string `json:"message"`
+	}
+	var e struct {
+		armError
+		Error *armError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		return ""
+	}
+	if e.Error != nil && e.Error.Message != "" {
+		return e.Error.Message
+	}
+	return e.Message
+}
+
+func errorInfo(respErr *azcore.ResponseError) *errdetails.ErrorInfo {
+	info := &errdetails.ErrorInfo{
+		Reason:   respErr.ErrorCode,
+		Domain:   armDomain,
+		Metadata: map[string]string{"httpStatus": strconv.Itoa(respErr.StatusCode)},
+	}
+	if info.Reason == "" {
+		info.Reason = http.StatusText(respErr.StatusCode)
+	}
+
+	resp := respErr.RawResponse
+	if resp == nil {
+		return info
+	}
+	if resp.Request != nil && resp.Request.URL != nil && resp.Request.URL.Host != "" {
+		info.Domain = resp.Request.URL.Host
+	}
+	if id := resp.Header.Get("x-ms-request-id"); id != "" {
+		info.Metadata["requestId"] = id
+	}
+	if id := resp.Header.Get("x-ms-correlation-request-id"); id != "" {
+		info.Metadata["correlationId"] = id
+	}
+	return info
+}
+
+// retryAfter extracts the Retry-After header, which can either be in seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func respErr(httpStatus int, armCode string, header http.Header) *azcore.ResponseError {
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("x-ms-request-id", "requestID")
+	return &azcore.ResponseError{
+		ErrorCode:  armCode,
+		StatusCode: httpStatus,
+		RawResponse: &http.Response{
+			StatusCode: httpStatus,
+			Header:     header,
+			Body:       http.NoBody,
+			Request:    &http.Request{URL: &url.URL{Host: "management.azure.com"}},
+		},
+	}
+}
+
+// respErrBody is like respErr(), but the response has body.
+func respErrBody(httpStatus int, armCode, body string) *azcore.ResponseError {
+	err := respErr(httpStatus, armCode, nil)
+	err.RawResponse.Body = io.NopCloser(strings.NewReader(body))
+	return err
+}
+
+func TestAzError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		err           error
+		wantCode      codes.Code
+		wantMsg       string // If set, the message of the status.
+		wantInfo      *errdetails.ErrorInfo
+		wantRetryInfo *errdetails.RetryInfo
+	}{
+		{
+			name:     "Not an Azure error",
+			err:      errors.New("error"),
+			wantCode: codes.Unknown,
+		},
+		{
+			name:     "Already a status error",
+			err:      status.Error(codes.Aborted, "aborted"),
+			wantCode: codes.Aborted,
+		},
+		{
+			name:     "Context deadline",
+			err:      context.DeadlineExceeded,
+			wantCode: codes.DeadlineExceeded,
+		},
+		{
+			name:     "404",
+			err:      respErr(http.StatusNotFound, "ResourceGroupNotFound", nil),
+			wantCode: codes.NotFound,
+			wantMsg:  "ARM returned HTTP 404: ResourceGroupNotFound",
+			wantInfo: &errdetails.ErrorInfo{
+				Reason:   "ResourceGroupNotFound",
+				Domain:   "management.azure.com",
+				Metadata: map[string]string{"httpStatus": "404", "requestId": "requestID"},
+			},
+		},
+		{
+			name:     "409 exists",
+			err:      respErr(http.StatusConflict, "ResourceGroupExists", nil),
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "409 being deleted",
+			err:      respErr(http.StatusConflict, "ResourceGroupBeingDeleted", nil),
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "403",
+			err:      respErr(http.StatusForbidden, "AuthorizationFailed", nil),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:          "429 with Retry-After",
+			err:           respErr(http.StatusTooManyRequests, "TooManyRequests", http.Header{"Retry-After": []string{"30"}}),
+			wantCode:      codes.ResourceExhausted,
+			wantRetryInfo: &errdetails.RetryInfo{RetryDelay: durationpb.New(30 * time.Second)},
+		},
+		{
+			name:     "400",
+			err:      respErr(http.StatusBadRequest, "InvalidParameter", nil),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "400 with the ARM message",
+			err:      respErrBody(http.StatusBadRequest, "InvalidParameter", `{"error":{"code":"InvalidParameter","message":"Location is required."}}`),
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "ARM returned HTTP 400: InvalidParameter: Location is required.",
+		},
+		{
+			name:     "400 with a bare ARM message",
+			err:      respErrBody(http.StatusBadRequest, "InvalidParameter", `{"code":"InvalidParameter","message":"Location is required."}`),
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "ARM returned HTTP 400: InvalidParameter: Location is required.",
+		},
+		{
+			name:     "400 with a body that is not JSON",
+			err:      respErrBody(http.StatusBadRequest, "InvalidParameter", "Bad Request"),
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "ARM returned HTTP 400: InvalidParameter",
+		},
+		{
+			name:     "503",
+			err:      respErr(http.StatusServiceUnavailable, "", nil),
+			wantCode: codes.Unavailable,
+		},
+	}
+
+	for _, test := range tests {
+		got := status.Convert(azError(test.err))
+		if got.Code() != test.wantCode {
+			t.Errorf("TestAzError(%s): got code %v, want %v", test.name, got.Code(), test.wantCode)
+			continue
+		}
+		if test.wantMsg != "" && got.Message() != test.wantMsg {
+			t.Errorf("TestAzError(%s): got message %q, want %q", test.name, got.Message(), test.wantMsg)
+		}
+
+		var info *errdetails.ErrorInfo
+		var retry *errdetails.RetryInfo
+		for _, d := range got.Details() {
+			switch t := d.(type) {
+			case *errdetails.ErrorInfo:
+				info = t
+			case *errdetails.RetryInfo:
+				retry = t
+			}
+		}
+		if test.wantInfo != nil {
+			if diff := cmp.Diff(test.wantInfo, info, protocmp.Transform()); diff != "" {
+				t.Errorf("TestAzError(%s): ErrorInfo -want/+got:\n%s", test.name, diff)
+			}
+		}
+		if diff := cmp.Diff(test.wantRetryInfo, retry, protocmp.Transform()); diff != "" {
+			t.Errorf("TestAzError(%s): RetryInfo -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
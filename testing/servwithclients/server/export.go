@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// ExportResourceGroupTemplate exports a resource group as an ARM template. The export is tracked as a
+// long-running operation and the RPC returns when it is done. If the RPC ends before the export is done,
+// the export continues and its result can be read with WaitOperation().
+func (s *Server) ExportResourceGroupTemplate(ctx context.Context, in *pb.ExportResourceGroupTemplateRequest) (*pb.ExportResourceGroupTemplateReply, error) {
+	client, rg, err := s.resourceGroup("Id", in.GetId(), in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := client.BeginExportTemplate(ctx, rg.name, exportRequest(in), nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opExportResourceGroupTemplate, rg.id(), exportPoller(poll))
+
+	select {
+	case <-ctx.Done():
+		return nil, status.Errorf(status.FromContextError(ctx.Err()).Code(), "export continues as operation %s, use WaitOperation() for the result", op.proto().Id)
+	case <-op.done:
+	}
+
+	p := op.proto()
+	switch p.State {
+	case pb.OperationState_OPERATION_STATE_SUCCEEDED:
+	case pb.OperationState_OPERATION_STATE_CANCELLED:
+		return nil, status.Errorf(codes.Canceled, "operation %s was cancelled", p.Id)
+	default:
+		return nil, status.Error(codes.Code(p.ErrorCode), p.ErrorMessage)
+	}
+
+	reply := &pb.ExportResourceGroupTemplateReply{}
+	if err := p.Result.UnmarshalTo(reply); err != nil {
+		return nil, status.Errorf(codes.Internal, "operation %s has an invalid result: %s", p.Id, err)
+	}
+	reply.OperationId = p.Id
+	return reply, nil
+}
+
+// exportRequest converts the options in an ExportResourceGroupTemplateRequest to an armresources.ExportTemplateRequest.
+func exportRequest(in *pb.ExportResourceGroupTemplateRequest) armresources.ExportTemplateRequest {
+	var opts []string
+	if in.GetIncludeParameterDefaultValues() {
+		opts = append(opts, "IncludeParameterDefaultValue")
+	}
+	if in.GetIncludeComments() {
+		opts = append(opts, "IncludeComments")
+	}
+
+	all := "*"
+	req := armresources.ExportTemplateRequest{Resources: []*string{&all}}
+	if len(opts) > 0 {
+		joined := strings.Join(opts, ",")
+		req.Options = &joined
+	}
+	return req
+}
+
+// exportPoller adapts the poller for an export so that its result is an ExportResourceGroupTemplateReply.
+func exportPoller(poll *runtime.Poller[armresources.ResourceGroupsClientExportTemplateResponse]) lroPoller {
+	return armPoller[armresources.ResourceGroupsClientExportTemplateResponse]{Poller: poll, convert: exportResult}
+}
+
+func exportResult(resp armresources.ResourceGroupsClientExportTemplateResponse) (*anypb.Any, error) {
+	reply := &pb.ExportResourceGroupTemplateReply{Errors: exportErrors(resp.Error)}
+	if resp.Template != nil {
+		b, err := json.Marshal(resp.Template)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal template: %w", err)
+		}
+		reply.Template = string(b)
+	}
+	return anypb.New(reply)
+}
+
+// exportErrors flattens an error reported by an export and its details.
+func exportErrors(e *armresources.ErrorResponse) []*pb.ExportError {
+	if e == nil {
+		return nil
+	}
+	out := []*pb.ExportError{{Code: deref(e.Code), Message: deref(e.Message), Target: deref(e.Target)}}
+	for _, d := range e.Details {
+		out = append(out, exportErrors(d)...)
+	}
+	return out
+}
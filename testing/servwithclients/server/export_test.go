@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func TestExportResourceGroupTemplate(t *testing.T) {
+	t.Parallel()
+
+	result := armresources.ResourceGroupsClientExportTemplateResponse{
+		ResourceGroupExportResult: armresources.ResourceGroupExportResult{
+			Template: map[string]any{"resources": []any{}},
+			Error: &armresources.ErrorResponse{
+				Code:    toPtr("ExportTemplateCompletedWithErrors"),
+				Message: toPtr("some resources were not exported"),
+				Details: []*armresources.ErrorResponse{
+					{Code: toPtr("ResourceTypeNotSupported"), Message: toPtr("not supported"), Target: toPtr("/subscriptions/s/resourceGroups/rg/providers/a/b/c")},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		req         *pb.ExportResourceGroupTemplateRequest
+		fakeCalls   *fakeResourceCalls
+		wantCode    codes.Code
+		wantOptions string
+		want        *pb.ExportResourceGroupTemplateReply
+	}{
+		{
+			name:      "Error: invalid name",
+			req:       &pb.ExportResourceGroupTemplateRequest{Id: "bad name!"},
+			fakeCalls: &fakeResourceCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Error: polling error",
+			req:  &pb.ExportResourceGroupTemplateRequest{Id: "rg"},
+			fakeCalls: &fakeResourceCalls{
+				beginExport: []any{armresources.ResourceGroupsClientExportTemplateResponse{}, errors.New("error")},
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Success",
+			req:  &pb.ExportResourceGroupTemplateRequest{Id: "rg", IncludeParameterDefaultValues: true, IncludeComments: true},
+			fakeCalls: &fakeResourceCalls{
+				beginExport: []any{armresources.ResourceGroupsClientExportTemplateResponse{}, result},
+			},
+			wantOptions: "IncludeParameterDefaultValue,IncludeComments",
+			want: &pb.ExportResourceGroupTemplateReply{
+				Template: `{"resources":[]}`,
+				Errors: []*pb.ExportError{
+					{Code: "ExportTemplateCompletedWithErrors", Message: "some resources were not exported"},
+					{Code: "ResourceTypeNotSupported", Message: "not supported", Target: "/subscriptions/s/resourceGroups/rg/providers/a/b/c"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient, ops: operations{pollFrequency: time.Millisecond}}
+		got, err := s.ExportResourceGroupTemplate(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestExportResourceGroupTemplate(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		if got.OperationId == "" {
+			t.Errorf("TestExportResourceGroupTemplate(%s): got empty OperationId", test.name)
+		}
+		got.OperationId = ""
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestExportResourceGroupTemplate(%s): -want/+got:\n%s", test.name, diff)
+		}
+		if gotOpts := deref(test.fakeCalls.gotExport[0].Options); gotOpts != test.wantOptions {
+			t.Errorf("TestExportResourceGroupTemplate(%s): got options %q, want %q", test.name, gotOpts, test.wantOptions)
+		}
+	}
+}
+
+func TestExportResourceGroupTemplateTimeout(t *testing.T) {
+	t.Parallel()
+
+	fakeCalls := &fakeResourceCalls{
+		beginExport: []any{
+			armresources.ResourceGroupsClientExportTemplateResponse{},
+			armresources.ResourceGroupsClientExportTemplateResponse{},
+			armresources.ResourceGroupsClientExportTemplateResponse{},
+		},
+	}
+	s := &Server{resourceClient: mustFakeResourceGroupClient(fakeCalls), ops: operations{pollFrequency: time.Hour}}
+
+	// The export won't be polled again for an hour, so the RPC times out first.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := s.ExportResourceGroupTemplate(ctx, &pb.ExportResourceGroupTemplateRequest{Id: "rg"})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("TestExportResourceGroupTemplateTimeout: got code %v, want %v", status.Code(err), codes.DeadlineExceeded)
+	}
+
+	ops := s.ops.list(opExportResourceGroupTemplate)
+	if len(ops) != 1 || ops[0].Target != "rg" || ops[0].State != pb.OperationState_OPERATION_STATE_RUNNING {
+		t.Fatalf("TestExportResourceGroupTemplateTimeout: got operations %v, want 1 running export of rg", ops)
+	}
+	s.ops.cancel(ops[0].Id)
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"net/http"
 	"slices"
+	"sync"
+	"time"
 
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources/fake"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	gpb "github.com/element-of-surprise/examples/testing/servwithclients/proto/greeter/proto"
 )
@@ -33,17 +36,103 @@ func (f *fakeGreeter) SayHello(ctx context.Context, req *gpb.HelloRequest, opts
 	panic("unknown return type")
 }
 
+// fakeServerStream implements the send side of a gRPC server stream, recording what was sent.
+// If sendErr is set, Send() returns it.
+type fakeServerStream[T any] struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	sent    []T
+	sendErr error
+	// sendDelay, if set, is how long each Send() takes.
+	sendDelay time.Duration
+}
+
+func (f *fakeServerStream[T]) Context() context.Context {
+	if f.ctx == nil {
+		return context.Background()
+	}
+	return f.ctx
+}
+
+func (f *fakeServerStream[T]) Send(msg T) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	time.Sleep(f.sendDelay)
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+// fakePoller implements lroPoller. Each call to Poll() returns the next entry in polls, an int HTTP status
+// or an error. Once polls is empty, Done() returns true and result() returns res and resErr.
+// If block is set, Poll() waits for it to be closed or for the ctx to be cancelled.
+type fakePoller struct {
+	mu     sync.Mutex
+	polls  []any
+	block  chan struct{}
+	token  string
+	res    *anypb.Any
+	resErr error
+}
+
+func (f *fakePoller) Done() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.polls) == 0
+}
+
+func (f *fakePoller) Poll(ctx context.Context) (*http.Response, error) {
+	if f.block != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-f.block:
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.polls) == 0 {
+		panic("unexpected call")
+	}
+	defer func() {
+		f.polls = slices.Delete(f.polls, 0, 1)
+	}()
+	switch t := f.polls[0].(type) {
+	case int:
+		return &http.Response{StatusCode: t}, nil
+	case error:
+		return nil, t
+	}
+	panic("unknown poll type")
+}
+
+func (f *fakePoller) ResumeToken() (string, error) {
+	return f.token, nil
+}
+
+func (f *fakePoller) result(ctx context.Context) (*anypb.Any, error) {
+	return f.res, f.resErr
+}
+
 // newResourceGroupsServer creates a fake server for the armresources.ResourceGroupsClient.
 func newResourceGroupsServer(f *fakeResourceCalls) fake.ResourceGroupsServer {
 	return fake.ResourceGroupsServer{
-		BeginDelete:    f.BeginDelete,
-		CreateOrUpdate: f.CreateOrUpdate,
-		Get:            f.Get,
-		Update:         f.Update,
-		NewListPager:   f.NewListPager,
+		BeginDelete:         f.BeginDelete,
+		BeginExportTemplate: f.BeginExportTemplate,
+		CreateOrUpdate:      f.CreateOrUpdate,
+		Get:                 f.Get,
+		CheckExistence:      f.CheckExistence,
+		Update:              f.Update,
+		NewListPager:        f.NewListPager,
 	}
 }
 
+// pollerErrorStatus is the HTTP status fake pollers fail with. A 5xx would be retried as a transient
+// error, but a fake poller only sends its terminal error once.
+const pollerErrorStatus = http.StatusConflict
+
 // fakeResourceCalls is used to build the responses for an armresources.ResourceGroupsClient that has
 // a fake server attached to it. You specify the responses that you wish to receive in the order that they will return.
 // For beginDelete and list, these are the set of responses that will be returned by the poller or the pager.
@@ -54,9 +143,16 @@ type fakeResourceCalls struct {
 	createOrUpdate []any //  armresources.ResourceGroupsClientCreateOrUpdateResponse or error
 	beginDelete    []any // armresources.ResourceGroupsClientDeleteResponse or error
 	beginDeleteErr error
+	beginExport    []any // armresources.ResourceGroupsClientExportTemplateResponse or error
 	get            []any // armresources.ResourceGroupsClientGetResponse or error
+	checkExistence []any // bool or error
 	list           []any // armresources.ResourceGroupsClientListResponse or error
 	update         []any // armresources.ResourceGroupsClientUpdateResponse or error
+
+	// gotUpdate records the parameters passed to each call to Update.
+	gotUpdate []armresources.ResourceGroupPatchable
+	// gotExport records the parameters passed to each call to BeginExportTemplate.
+	gotExport []armresources.ExportTemplateRequest
 }
 
 func (f *fakeResourceCalls) CreateOrUpdate(ctx context.Context, resourceGroupName string, parameters armresources.ResourceGroup, options *armresources.ResourceGroupsClientCreateOrUpdateOptions) (resp azfake.Responder[armresources.ResourceGroupsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
@@ -94,7 +190,28 @@ func (f *fakeResourceCalls) BeginDelete(ctx context.Context, resourceGroupName s
 			}
 			poller.AddNonTerminalResponse(http.StatusAccepted, nil)
 		case error:
-			poller.SetTerminalError(http.StatusInternalServerError, t.Error())
+			poller.SetTerminalError(pollerErrorStatus, t.Error())
+		default:
+			panic("unknown return type")
+		}
+	}
+	return poller, azfake.ErrorResponder{}
+}
+
+func (f *fakeResourceCalls) BeginExportTemplate(ctx context.Context, resourceGroupName string, parameters armresources.ExportTemplateRequest, options *armresources.ResourceGroupsClientBeginExportTemplateOptions) (azfake.PollerResponder[armresources.ResourceGroupsClientExportTemplateResponse], azfake.ErrorResponder) {
+	f.gotExport = append(f.gotExport, parameters)
+
+	poller := azfake.PollerResponder[armresources.ResourceGroupsClientExportTemplateResponse]{}
+	for i, r := range f.beginExport {
+		switch t := r.(type) {
+		case armresources.ResourceGroupsClientExportTemplateResponse:
+			if i == len(f.beginExport)-1 {
+				poller.SetTerminalResponse(http.StatusOK, t, nil)
+				continue
+			}
+			poller.AddNonTerminalResponse(http.StatusAccepted, nil)
+		case error:
+			poller.SetTerminalError(pollerErrorStatus, t.Error())
 		default:
 			panic("unknown return type")
 		}
@@ -122,10 +239,35 @@ func (f *fakeResourceCalls) Get(ctx context.Context, resourceGroupName string, o
 	panic("unknown return type")
 }
 
+func (f *fakeResourceCalls) CheckExistence(ctx context.Context, resourceGroupName string, options *armresources.ResourceGroupsClientCheckExistenceOptions) (resp azfake.Responder[armresources.ResourceGroupsClientCheckExistenceResponse], errResp azfake.ErrorResponder) {
+	if len(f.checkExistence) == 0 {
+		panic("unexpected call")
+	}
+
+	defer func() {
+		f.checkExistence = slices.Delete(f.checkExistence, 0, 1)
+	}()
+
+	switch t := f.checkExistence[0].(type) {
+	case bool:
+		code := http.StatusNotFound
+		if t {
+			code = http.StatusNoContent
+		}
+		resp.SetResponse(code, armresources.ResourceGroupsClientCheckExistenceResponse{Success: t}, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.ResourceGroupsClientCheckExistenceResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
+
 func (f *fakeResourceCalls) Update(ctx context.Context, resourceGroupName string, parameters armresources.ResourceGroupPatchable, options *armresources.ResourceGroupsClientUpdateOptions) (resp azfake.Responder[armresources.ResourceGroupsClientUpdateResponse], errResp azfake.ErrorResponder) {
 	if len(f.update) == 0 {
 		panic("unexpected call")
 	}
+	f.gotUpdate = append(f.gotUpdate, parameters)
 
 	defer func() {
 		f.update = slices.Delete(f.update, 0, 1)
@@ -161,3 +303,394 @@ func (f *fakeResourceCalls) NewListPager(options *armresources.ResourceGroupsCli
 	}
 	return pager
 }
+
+// newGenericServer creates a fake server for the armresources.Client.
+func newGenericServer(f *fakeGenericCalls) fake.Server {
+	return fake.Server{
+		GetByID:                     f.GetByID,
+		BeginCreateOrUpdateByID:     f.BeginCreateOrUpdateByID,
+		BeginUpdateByID:             f.BeginUpdateByID,
+		BeginDeleteByID:             f.BeginDeleteByID,
+		NewListByResourceGroupPager: f.NewListByResourceGroupPager,
+		BeginValidateMoveResources:  f.BeginValidateMoveResources,
+		BeginMoveResources:          f.BeginMoveResources,
+	}
+}
+
+// fakeGenericCalls is used to build the responses for an armresources.Client that has a fake server
+// attached to it, in the same way as fakeResourceCalls.
+type fakeGenericCalls struct {
+	getByID             []any // armresources.ClientGetByIDResponse or error
+	beginCreateByID     []any // armresources.ClientCreateOrUpdateByIDResponse or error
+	beginUpdateByID     []any // armresources.ClientUpdateByIDResponse or error
+	beginDeleteByID     []any // armresources.ClientDeleteByIDResponse or error
+	listByResourceGroup []any // armresources.ClientListByResourceGroupResponse or error
+	beginValidateMove   []any // armresources.ClientValidateMoveResourcesResponse or error
+	beginMove           []any // armresources.ClientMoveResourcesResponse or error
+
+	// gotAPIVersions records the API version passed to each call.
+	gotAPIVersions []string
+	// gotParams records the parameters passed to each call to BeginCreateOrUpdateByID or BeginUpdateByID.
+	gotParams []armresources.GenericResource
+	// gotMoves records the parameters passed to each call to BeginValidateMoveResources or BeginMoveResources.
+	gotMoves []armresources.MoveInfo
+}
+
+func (f *fakeGenericCalls) GetByID(ctx context.Context, resourceID string, apiVersion string, options *armresources.ClientGetByIDOptions) (resp azfake.Responder[armresources.ClientGetByIDResponse], errResp azfake.ErrorResponder) {
+	if len(f.getByID) == 0 {
+		panic("unexpected call")
+	}
+	f.gotAPIVersions = append(f.gotAPIVersions, apiVersion)
+
+	defer func() {
+		f.getByID = slices.Delete(f.getByID, 0, 1)
+	}()
+
+	switch t := f.getByID[0].(type) {
+	case armresources.ClientGetByIDResponse:
+		resp.SetResponse(http.StatusOK, t, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.ClientGetByIDResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
+
+func (f *fakeGenericCalls) BeginCreateOrUpdateByID(ctx context.Context, resourceID string, apiVersion string, parameters armresources.GenericResource, options *armresources.ClientBeginCreateOrUpdateByIDOptions) (azfake.PollerResponder[armresources.ClientCreateOrUpdateByIDResponse], azfake.ErrorResponder) {
+	f.gotAPIVersions = append(f.gotAPIVersions, apiVersion)
+	f.gotParams = append(f.gotParams, parameters)
+	return pollerResponder[armresources.ClientCreateOrUpdateByIDResponse](f.beginCreateByID), azfake.ErrorResponder{}
+}
+
+func (f *fakeGenericCalls) BeginUpdateByID(ctx context.Context, resourceID string, apiVersion string, parameters armresources.GenericResource, options *armresources.ClientBeginUpdateByIDOptions) (azfake.PollerResponder[armresources.ClientUpdateByIDResponse], azfake.ErrorResponder) {
+	f.gotAPIVersions = append(f.gotAPIVersions, apiVersion)
+	f.gotParams = append(f.gotParams, parameters)
+	return pollerResponder[armresources.ClientUpdateByIDResponse](f.beginUpdateByID), azfake.ErrorResponder{}
+}
+
+func (f *fakeGenericCalls) BeginDeleteByID(ctx context.Context, resourceID string, apiVersion string, options *armresources.ClientBeginDeleteByIDOptions) (azfake.PollerResponder[armresources.ClientDeleteByIDResponse], azfake.ErrorResponder) {
+	f.gotAPIVersions = append(f.gotAPIVersions, apiVersion)
+	return pollerResponder[armresources.ClientDeleteByIDResponse](f.beginDeleteByID), azfake.ErrorResponder{}
+}
+
+func (f *fakeGenericCalls) NewListByResourceGroupPager(resourceGroupName string, options *armresources.ClientListByResourceGroupOptions) (resp azfake.PagerResponder[armresources.ClientListByResourceGroupResponse]) {
+	pager := azfake.PagerResponder[armresources.ClientListByResourceGroupResponse]{}
+
+	for i, item := range f.listByResourceGroup {
+		switch t := item.(type) {
+		case armresources.ClientListByResourceGroupResponse:
+			pager.AddPage(http.StatusOK, t, nil)
+		case error:
+			if i == len(f.listByResourceGroup)-1 {
+				pager.AddError(t)
+				continue
+			}
+			pager.AddResponseError(http.StatusRequestTimeout, t.Error())
+		default:
+			panic("unknown return type")
+		}
+	}
+	return pager
+}
+
+func (f *fakeGenericCalls) BeginValidateMoveResources(ctx context.Context, sourceResourceGroupName string, parameters armresources.MoveInfo, options *armresources.ClientBeginValidateMoveResourcesOptions) (azfake.PollerResponder[armresources.ClientValidateMoveResourcesResponse], azfake.ErrorResponder) {
+	f.gotMoves = append(f.gotMoves, parameters)
+	return pollerResponderStatus[armresources.ClientValidateMoveResourcesResponse](f.beginValidateMove, http.StatusNoContent), azfake.ErrorResponder{}
+}
+
+func (f *fakeGenericCalls) BeginMoveResources(ctx context.Context, sourceResourceGroupName string, parameters armresources.MoveInfo, options *armresources.ClientBeginMoveResourcesOptions) (azfake.PollerResponder[armresources.ClientMoveResourcesResponse], azfake.ErrorResponder) {
+	f.gotMoves = append(f.gotMoves, parameters)
+	return pollerResponderStatus[armresources.ClientMoveResourcesResponse](f.beginMove, http.StatusNoContent), azfake.ErrorResponder{}
+}
+
+// pollerResponder builds the responses of a fake poller from responses, which are either a T or an error.
+// The last T is the terminal response, the ones before it are non-terminal. An error is a terminal error.
+func pollerResponder[T any](responses []any) azfake.PollerResponder[T] {
+	return pollerResponderStatus[T](responses, http.StatusOK)
+}
+
+// pollerResponderStatus is like pollerResponder, for operations that ARM ends with terminalStatus rather than 200.
+func pollerResponderStatus[T any](responses []any, terminalStatus int) azfake.PollerResponder[T] {
+	poller := azfake.PollerResponder[T]{}
+	for i, r := range responses {
+		switch t := r.(type) {
+		case T:
+			if i == len(responses)-1 {
+				poller.SetTerminalResponse(terminalStatus, t, nil)
+				continue
+			}
+			poller.AddNonTerminalResponse(http.StatusAccepted, nil)
+		case error:
+			poller.SetTerminalError(pollerErrorStatus, t.Error())
+		default:
+			panic("unknown return type")
+		}
+	}
+	return poller
+}
+
+// newProvidersServer creates a fake server for the armresources.ProvidersClient.
+func newProvidersServer(f *fakeProvidersCalls) fake.ProvidersServer {
+	return fake.ProvidersServer{
+		Get:          f.Get,
+		NewListPager: f.NewListPager,
+		Register:     f.Register,
+		Unregister:   f.Unregister,
+	}
+}
+
+// fakeProvidersCalls is used to build the responses for an armresources.ProvidersClient that has a fake
+// server attached to it, in the same way as fakeResourceCalls.
+type fakeProvidersCalls struct {
+	get        []any // armresources.ProvidersClientGetResponse or error
+	list       []any // armresources.ProvidersClientListResponse or error
+	register   []any // armresources.ProvidersClientRegisterResponse or error
+	unregister []any // armresources.ProvidersClientUnregisterResponse or error
+
+	// gotGet records the namespace passed to each call to Get.
+	gotGet []string
+}
+
+func (f *fakeProvidersCalls) Get(ctx context.Context, resourceProviderNamespace string, options *armresources.ProvidersClientGetOptions) (resp azfake.Responder[armresources.ProvidersClientGetResponse], errResp azfake.ErrorResponder) {
+	if len(f.get) == 0 {
+		panic("unexpected call")
+	}
+	f.gotGet = append(f.gotGet, resourceProviderNamespace)
+
+	defer func() {
+		f.get = slices.Delete(f.get, 0, 1)
+	}()
+
+	switch t := f.get[0].(type) {
+	case armresources.ProvidersClientGetResponse:
+		resp.SetResponse(http.StatusOK, t, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.ProvidersClientGetResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
+
+// newDeploymentsServer creates a fake server for the armresources.DeploymentsClient.
+func newDeploymentsServer(f *fakeDeploymentsCalls) fake.DeploymentsServer {
+	return fake.DeploymentsServer{
+		BeginCreateOrUpdate:         f.BeginCreateOrUpdate,
+		BeginValidate:               f.BeginValidate,
+		BeginWhatIf:                 f.BeginWhatIf,
+		Get:                         f.Get,
+		NewListByResourceGroupPager: f.NewListByResourceGroupPager,
+	}
+}
+
+// fakeDeploymentsCalls is used to build the responses for an armresources.DeploymentsClient that has a
+// fake server attached to it, in the same way as fakeResourceCalls.
+type fakeDeploymentsCalls struct {
+	beginCreateOrUpdate []any // armresources.DeploymentsClientCreateOrUpdateResponse or error
+	beginValidate       []any // armresources.DeploymentsClientValidateResponse or error
+	beginWhatIf         []any // armresources.DeploymentsClientWhatIfResponse or error
+	get                 []any // armresources.DeploymentsClientGetResponse or error
+	list                []any // armresources.DeploymentsClientListByResourceGroupResponse or error
+
+	// gotDeployments records the parameters passed to each call to BeginCreateOrUpdate or BeginValidate.
+	gotDeployments []armresources.Deployment
+	// gotWhatIfs records the parameters passed to each call to BeginWhatIf.
+	gotWhatIfs []armresources.DeploymentWhatIf
+}
+
+func (f *fakeDeploymentsCalls) BeginCreateOrUpdate(ctx context.Context, resourceGroupName string, deploymentName string, parameters armresources.Deployment, options *armresources.DeploymentsClientBeginCreateOrUpdateOptions) (azfake.PollerResponder[armresources.DeploymentsClientCreateOrUpdateResponse], azfake.ErrorResponder) {
+	f.gotDeployments = append(f.gotDeployments, parameters)
+	return pollerResponder[armresources.DeploymentsClientCreateOrUpdateResponse](f.beginCreateOrUpdate), azfake.ErrorResponder{}
+}
+
+func (f *fakeDeploymentsCalls) BeginValidate(ctx context.Context, resourceGroupName string, deploymentName string, parameters armresources.Deployment, options *armresources.DeploymentsClientBeginValidateOptions) (azfake.PollerResponder[armresources.DeploymentsClientValidateResponse], azfake.ErrorResponder) {
+	f.gotDeployments = append(f.gotDeployments, parameters)
+	return pollerResponder[armresources.DeploymentsClientValidateResponse](f.beginValidate), azfake.ErrorResponder{}
+}
+
+func (f *fakeDeploymentsCalls) BeginWhatIf(ctx context.Context, resourceGroupName string, deploymentName string, parameters armresources.DeploymentWhatIf, options *armresources.DeploymentsClientBeginWhatIfOptions) (azfake.PollerResponder[armresources.DeploymentsClientWhatIfResponse], azfake.ErrorResponder) {
+	f.gotWhatIfs = append(f.gotWhatIfs, parameters)
+	return pollerResponder[armresources.DeploymentsClientWhatIfResponse](f.beginWhatIf), azfake.ErrorResponder{}
+}
+
+func (f *fakeDeploymentsCalls) Get(ctx context.Context, resourceGroupName string, deploymentName string, options *armresources.DeploymentsClientGetOptions) (resp azfake.Responder[armresources.DeploymentsClientGetResponse], errResp azfake.ErrorResponder) {
+	if len(f.get) == 0 {
+		panic("unexpected call")
+	}
+
+	defer func() {
+		f.get = slices.Delete(f.get, 0, 1)
+	}()
+
+	switch t := f.get[0].(type) {
+	case armresources.DeploymentsClientGetResponse:
+		resp.SetResponse(http.StatusOK, t, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.DeploymentsClientGetResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
+
+func (f *fakeDeploymentsCalls) NewListByResourceGroupPager(resourceGroupName string, options *armresources.DeploymentsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armresources.DeploymentsClientListByResourceGroupResponse]) {
+	pager := azfake.PagerResponder[armresources.DeploymentsClientListByResourceGroupResponse]{}
+
+	for i, item := range f.list {
+		switch t := item.(type) {
+		case armresources.DeploymentsClientListByResourceGroupResponse:
+			pager.AddPage(http.StatusOK, t, nil)
+		case error:
+			if i == len(f.list)-1 {
+				pager.AddError(t)
+				continue
+			}
+			pager.AddResponseError(http.StatusRequestTimeout, t.Error())
+		default:
+			panic("unknown return type")
+		}
+	}
+	return pager
+}
+
+// newTagsServer creates a fake server for the armresources.TagsClient.
+func newTagsServer(f *fakeTagsCalls) fake.TagsServer {
+	return fake.TagsServer{
+		GetAtScope:    f.GetAtScope,
+		UpdateAtScope: f.UpdateAtScope,
+		NewListPager:  f.NewListPager,
+	}
+}
+
+// fakeTagsCalls is used to build the responses for an armresources.TagsClient that has a fake server
+// attached to it, in the same way as fakeResourceCalls.
+type fakeTagsCalls struct {
+	getAtScope    []any // armresources.TagsClientGetAtScopeResponse or error
+	updateAtScope []any // armresources.TagsClientUpdateAtScopeResponse or error
+	list          []any // armresources.TagsClientListResponse or error
+
+	// gotPatches records the parameters passed to each call to UpdateAtScope.
+	gotPatches []armresources.TagsPatchResource
+}
+
+func (f *fakeTagsCalls) GetAtScope(ctx context.Context, scope string, options *armresources.TagsClientGetAtScopeOptions) (resp azfake.Responder[armresources.TagsClientGetAtScopeResponse], errResp azfake.ErrorResponder) {
+	if len(f.getAtScope) == 0 {
+		panic("unexpected call")
+	}
+
+	defer func() {
+		f.getAtScope = slices.Delete(f.getAtScope, 0, 1)
+	}()
+
+	switch t := f.getAtScope[0].(type) {
+	case armresources.TagsClientGetAtScopeResponse:
+		resp.SetResponse(http.StatusOK, t, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.TagsClientGetAtScopeResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
+
+func (f *fakeTagsCalls) UpdateAtScope(ctx context.Context, scope string, parameters armresources.TagsPatchResource, options *armresources.TagsClientUpdateAtScopeOptions) (resp azfake.Responder[armresources.TagsClientUpdateAtScopeResponse], errResp azfake.ErrorResponder) {
+	if len(f.updateAtScope) == 0 {
+		panic("unexpected call")
+	}
+	f.gotPatches = append(f.gotPatches, parameters)
+
+	defer func() {
+		f.updateAtScope = slices.Delete(f.updateAtScope, 0, 1)
+	}()
+
+	switch t := f.updateAtScope[0].(type) {
+	case armresources.TagsClientUpdateAtScopeResponse:
+		resp.SetResponse(http.StatusOK, t, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.TagsClientUpdateAtScopeResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
+
+func (f *fakeTagsCalls) NewListPager(options *armresources.TagsClientListOptions) (resp azfake.PagerResponder[armresources.TagsClientListResponse]) {
+	pager := azfake.PagerResponder[armresources.TagsClientListResponse]{}
+
+	for i, item := range f.list {
+		switch t := item.(type) {
+		case armresources.TagsClientListResponse:
+			pager.AddPage(http.StatusOK, t, nil)
+		case error:
+			if i == len(f.list)-1 {
+				pager.AddError(t)
+				continue
+			}
+			pager.AddResponseError(http.StatusRequestTimeout, t.Error())
+		default:
+			panic("unknown return type")
+		}
+	}
+	return pager
+}
+
+func (f *fakeProvidersCalls) NewListPager(options *armresources.ProvidersClientListOptions) (resp azfake.PagerResponder[armresources.ProvidersClientListResponse]) {
+	pager := azfake.PagerResponder[armresources.ProvidersClientListResponse]{}
+
+	for i, item := range f.list {
+		switch t := item.(type) {
+		case armresources.ProvidersClientListResponse:
+			pager.AddPage(http.StatusOK, t, nil)
+		case error:
+			if i == len(f.list)-1 {
+				pager.AddError(t)
+				continue
+			}
+			pager.AddResponseError(http.StatusRequestTimeout, t.Error())
+		default:
+			panic("unknown return type")
+		}
+	}
+	return pager
+}
+
+func (f *fakeProvidersCalls) Register(ctx context.Context, resourceProviderNamespace string, options *armresources.ProvidersClientRegisterOptions) (resp azfake.Responder[armresources.ProvidersClientRegisterResponse], errResp azfake.ErrorResponder) {
+	if len(f.register) == 0 {
+		panic("unexpected call")
+	}
+
+	defer func() {
+		f.register = slices.Delete(f.register, 0, 1)
+	}()
+
+	switch t := f.register[0].(type) {
+	case armresources.ProvidersClientRegisterResponse:
+		resp.SetResponse(http.StatusOK, t, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.ProvidersClientRegisterResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
+
+func (f *fakeProvidersCalls) Unregister(ctx context.Context, resourceProviderNamespace string, options *armresources.ProvidersClientUnregisterOptions) (resp azfake.Responder[armresources.ProvidersClientUnregisterResponse], errResp azfake.ErrorResponder) {
+	if len(f.unregister) == 0 {
+		panic("unexpected call")
+	}
+
+	defer func() {
+		f.unregister = slices.Delete(f.unregister, 0, 1)
+	}()
+
+	switch t := f.unregister[0].(type) {
+	case armresources.ProvidersClientUnregisterResponse:
+		resp.SetResponse(http.StatusOK, t, nil)
+		return resp, azfake.ErrorResponder{}
+	case error:
+		errResp.SetError(t)
+		return azfake.Responder[armresources.ProvidersClientUnregisterResponse]{}, errResp
+	}
+	panic("unknown return type")
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// listFilter filters resource groups being listed. Filters that ARM supports are sent in the
+// list options, the rest are applied by match().
+type listFilter struct {
+	// subscription is the lower cased subscription being listed. It is part of the filter so that a page token
+	// can't be used to list another subscription. ListResourceGroups() sets it to the server's subscription
+	// when the request has none, so both forms hash the same.
+	subscription string
+	name         string
+	glob         bool
+	region       string
+	tagName      string
+	tagValue     string
+}
+
+// filterRequest is implemented by requests that filter resource groups.
+type filterRequest interface {
+	GetName() string
+	GetRegion() string
+	GetTagName() string
+	GetTagValue() string
+	GetSubscription() string
+}
+
+var (
+	_ filterRequest = (*pb.ListResourceGroupsRequest)(nil)
+	_ filterRequest = (*pb.StreamResourceGroupsRequest)(nil)
+)
+
+// newListFilter creates a listFilter from a request.
+func newListFilter(in filterRequest) (listFilter, error) {
+	f := listFilter{
+		subscription: strings.ToLower(in.GetSubscription()),
+		name:         strings.ToLower(in.GetName()),
+		region:       normalizeRegion(in.GetRegion()),
+		tagName:      in.GetTagName(),
+		tagValue:     in.GetTagValue(),
+	}
+	if strings.ContainsAny(f.name, "*?[") {
+		if _, err := path.Match(f.name, ""); err != nil {
+			return listFilter{}, fmt.Errorf("Name is not a valid glob: %w", err)
+		}
+		f.glob = true
+	}
+	if f.tagValue != "" && f.tagName == "" {
+		return listFilter{}, errors.New("TagValue requires TagName")
+	}
+	return f, nil
+}
+
+// options returns the list options that push the filters ARM supports to ARM.
+// ARM can filter resource groups by tag, but not by name or location.
+func (f listFilter) options() *armresources.ResourceGroupsClientListOptions {
+	if f.tagName == "" {
+		return nil
+	}
+	filter := fmt.Sprintf("tagName eq '%s'", odataEscape(f.tagName))
+	if f.tagValue != "" {
+		filter += fmt.Sprintf(" and tagValue eq '%s'", odataEscape(f.tagValue))
+	}
+	return &armresources.ResourceGroupsClientListOptions{Filter: &filter}
+}
+
+// match reports if g passes the filter.
+func (f listFilter) match(g *armresources.ResourceGroup) bool {
+	name := strings.ToLower(deref(g.Name))
+	switch {
+	case f.glob:
+		if ok, _ := path.Match(f.name, name); !ok {
+			return false
+		}
+	case !strings.HasPrefix(name, f.name):
+		return false
+	}
+
+	if f.region != "" && f.region != normalizeRegion(deref(g.Location)) {
+		return false
+	}
+
+	if f.tagName != "" {
+		found := false
+		for k, v := range g.Tags {
+			if !strings.EqualFold(k, f.tagName) {
+				continue
+			}
+			if f.tagValue == "" || f.tagValue == deref(v) {
+				found = true
+			}
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hash identifies the filter, so that a page token can only be used with the filter that created it.
+func (f listFilter) hash() string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%q|%q|%q|%q|%q", f.subscription, f.name, f.region, f.tagName, f.tagValue)))
+	return base64.RawURLEncoding.EncodeToString(h[:8])
+}
+
+// normalizeRegion allows the display name of a region, such as "West US", to match its name, "westus".
+func normalizeRegion(r string) string {
+	return strings.ToLower(strings.ReplaceAll(r, " ", ""))
+}
+
+// odataEscape escapes a string literal for use in an OData filter.
+func odataEscape(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
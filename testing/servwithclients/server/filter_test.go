@@ -0,0 +1,106 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func TestListFilter(t *testing.T) {
+	t.Parallel()
+
+	group := &armresources.ResourceGroup{
+		Name:     toPtr("Prod-Web"),
+		Location: toPtr("westus2"),
+		Tags:     map[string]*string{"Owner": toPtr("bob")},
+	}
+
+	tests := []struct {
+		name       string
+		req        *pb.ListResourceGroupsRequest
+		wantErr    bool
+		wantMatch  bool
+		wantFilter string
+	}{
+		{
+			name:      "No filter",
+			req:       &pb.ListResourceGroupsRequest{},
+			wantMatch: true,
+		},
+		{
+			name:      "Name prefix",
+			req:       &pb.ListResourceGroupsRequest{Name: "prod-"},
+			wantMatch: true,
+		},
+		{
+			name: "Name prefix doesn't match",
+			req:  &pb.ListResourceGroupsRequest{Name: "dev-"},
+		},
+		{
+			name:      "Name glob",
+			req:       &pb.ListResourceGroupsRequest{Name: "*-web"},
+			wantMatch: true,
+		},
+		{
+			name: "Name glob doesn't match",
+			req:  &pb.ListResourceGroupsRequest{Name: "*-db"},
+		},
+		{
+			name:      "Region display name",
+			req:       &pb.ListResourceGroupsRequest{Region: "West US 2"},
+			wantMatch: true,
+		},
+		{
+			name: "Region doesn't match",
+			req:  &pb.ListResourceGroupsRequest{Region: "eastus"},
+		},
+		{
+			name:       "Tag name and value",
+			req:        &pb.ListResourceGroupsRequest{TagName: "owner", TagValue: "bob"},
+			wantMatch:  true,
+			wantFilter: "tagName eq 'owner' and tagValue eq 'bob'",
+		},
+		{
+			name:       "Tag value doesn't match",
+			req:        &pb.ListResourceGroupsRequest{TagName: "owner", TagValue: "o'brien"},
+			wantFilter: "tagName eq 'owner' and tagValue eq 'o''brien'",
+		},
+		{
+			name:    "Error: bad glob",
+			req:     &pb.ListResourceGroupsRequest{Name: "[prod"},
+			wantErr: true,
+		},
+		{
+			name:    "Error: TagValue without TagName",
+			req:     &pb.ListResourceGroupsRequest{TagValue: "bob"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		f, err := newListFilter(test.req)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestListFilter(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestListFilter(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+
+		if got := f.match(group); got != test.wantMatch {
+			t.Errorf("TestListFilter(%s): got match == %v, want %v", test.name, got, test.wantMatch)
+		}
+		gotFilter := ""
+		if opts := f.options(); opts != nil {
+			gotFilter = *opts.Filter
+		}
+		if gotFilter != test.wantFilter {
+			t.Errorf("TestListFilter(%s): got OData filter %q, want %q", test.name, gotFilter, test.wantFilter)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// ValidateMoveResources starts checking that resources can be moved to another resource group.
+// The operation fails with the reason ARM gives if they cannot be moved.
+func (s *Server) ValidateMoveResources(ctx context.Context, in *pb.MoveResourcesRequest) (*pb.ValidateMoveResourcesReply, error) {
+	client, src, info, err := s.moveRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := client.BeginValidateMoveResources(ctx, src.name, info, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opValidateMoveResources, src.id(), armPoller[armresources.ClientValidateMoveResourcesResponse]{Poller: poll})
+
+	return &pb.ValidateMoveResourcesReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// MoveResources starts moving resources to another resource group.
+func (s *Server) MoveResources(ctx context.Context, in *pb.MoveResourcesRequest) (*pb.MoveResourcesReply, error) {
+	client, src, info, err := s.moveRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := client.BeginMoveResources(ctx, src.name, info, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opMoveResources, src.id(), armPoller[armresources.ClientMoveResourcesResponse]{Poller: poll})
+
+	return &pb.MoveResourcesReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// moveRequest validates a MoveResourcesRequest and converts it to an armresources.MoveInfo. It returns
+// the client for the subscription of the source resource group and the source resource group, with
+// its subscription set.
+func (s *Server) moveRequest(in *pb.MoveResourcesRequest) (genericClient, rgRef, armresources.MoveInfo, error) {
+	src, err := parseRGRequest("SourceResourceGroup", in.GetSourceResourceGroup(), in.GetSubscription())
+	if err != nil {
+		return nil, rgRef{}, armresources.MoveInfo{}, err
+	}
+	// ARM needs the ID of the target resource group and the resource IDs are checked against the
+	// source, so both need the subscription.
+	if src.subscription == "" {
+		src.subscription = s.subscriptionID
+	}
+	if src.subscription == "" {
+		return nil, rgRef{}, armresources.MoveInfo{}, status.Error(codes.FailedPrecondition, "server does not know its subscription, so SourceResourceGroup must be an ARM ID")
+	}
+
+	v := violations{}
+	target := parseRGRef(&v, "TargetResourceGroup", in.GetTargetResourceGroup())
+	if target.subscription == "" {
+		target.subscription = src.subscription
+	}
+	if target.name != "" && strings.EqualFold(target.id(), src.id()) {
+		v.add("TargetResourceGroup", "must be different from SourceResourceGroup")
+	}
+
+	info := armresources.MoveInfo{TargetResourceGroup: optional(target.id())}
+	if len(in.GetResourceIds()) == 0 {
+		v.add("ResourceIds", "at least one resource is required")
+	}
+	for i, id := range in.GetResourceIds() {
+		field := fmt.Sprintf("ResourceIds[%d]", i)
+		rid, err := arm.ParseResourceID(id)
+		switch {
+		case err != nil:
+			v.add(field, "%s", err)
+			continue
+		case !strings.EqualFold(rid.SubscriptionID, src.subscription) || !strings.EqualFold(rid.ResourceGroupName, src.name):
+			v.add(field, "is not in SourceResourceGroup")
+			continue
+		case strings.EqualFold(rid.ResourceType.String(), arm.ResourceGroupResourceType.String()):
+			// The ID of the resource group parses as being in itself, but a resource group can't be moved.
+			v.add(field, "is SourceResourceGroup, only resources in it can be moved")
+			continue
+		}
+		info.Resources = append(info.Resources, optional(id))
+	}
+	if err := v.err(); err != nil {
+		return nil, rgRef{}, armresources.MoveInfo{}, err
+	}
+
+	// Moving resources into a subscription is only allowed if the server manages it.
+	if _, err := s.clientsFor(target.subscription); err != nil {
+		return nil, rgRef{}, armresources.MoveInfo{}, err
+	}
+	client, err := s.genericClientFor(src.subscription)
+	if err != nil {
+		return nil, rgRef{}, armresources.MoveInfo{}, err
+	}
+	return client, src, info, nil
+}
+
+// resumeMovePoller recreates the poller for a move operation of kind from its resume token. target is the
+// ID of the source resource group.
+func (s *Server) resumeMovePoller(kind, target, token string) (lroPoller, error) {
+	rg, err := parseRGRequest("Target", target, "")
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.genericClientFor(rg.subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	switch kind {
+	case opValidateMoveResources:
+		poll, err := client.BeginValidateMoveResources(ctx, rg.name, armresources.MoveInfo{}, &armresources.ClientBeginValidateMoveResourcesOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return armPoller[armresources.ClientValidateMoveResourcesResponse]{Poller: poll}, nil
+	case opMoveResources:
+		poll, err := client.BeginMoveResources(ctx, rg.name, armresources.MoveInfo{}, &armresources.ClientBeginMoveResourcesOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return armPoller[armresources.ClientMoveResourcesResponse]{Poller: poll}, nil
+	}
+	return nil, fmt.Errorf("unknown operation kind %q", kind)
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func TestMoveResources(t *testing.T) {
+	t.Parallel()
+
+	const (
+		rgID     = "/subscriptions/subscriptionID/resourceGroups/rg"
+		targetID = "/subscriptions/subscriptionID/resourceGroups/target"
+	)
+
+	tests := []struct {
+		name           string
+		req            *pb.MoveResourcesRequest
+		subscriptionID string
+		fakeCalls      *fakeGenericCalls
+		wantCode       codes.Code
+		// wantViolations, if set, are the fields of the violations in the error.
+		wantViolations []string
+		wantState      pb.OperationState
+		wantMove       armresources.MoveInfo
+	}{
+		{
+			name:           "Error: server does not know its subscription",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "rg", ResourceIds: []string{vnetID}, TargetResourceGroup: "target"},
+			subscriptionID: "",
+			fakeCalls:      &fakeGenericCalls{},
+			wantCode:       codes.FailedPrecondition,
+		},
+		{
+			name:           "Error: no resources",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "rg", TargetResourceGroup: "target"},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{},
+			wantCode:       codes.InvalidArgument,
+		},
+		{
+			name: "Error: resource in another resource group",
+			req: &pb.MoveResourcesRequest{
+				SourceResourceGroup: "rg",
+				ResourceIds:         []string{"/subscriptions/subscriptionID/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/vnet"},
+				TargetResourceGroup: "target",
+			},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{},
+			wantCode:       codes.InvalidArgument,
+		},
+		{
+			name:           "Error: resource is the source resource group",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "rg", ResourceIds: []string{vnetID, rgID}, TargetResourceGroup: "target"},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{},
+			wantCode:       codes.InvalidArgument,
+			wantViolations: []string{"ResourceIds[1]"},
+		},
+		{
+			name:           "Error: invalid target",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "rg", ResourceIds: []string{vnetID}, TargetResourceGroup: "bad name!"},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{},
+			wantCode:       codes.InvalidArgument,
+		},
+		{
+			name:           "Error: target is the source",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "RG", ResourceIds: []string{vnetID}, TargetResourceGroup: rgID},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{},
+			wantCode:       codes.InvalidArgument,
+		},
+		{
+			name:           "Error: target in a subscription the server does not manage",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "rg", ResourceIds: []string{vnetID}, TargetResourceGroup: "/subscriptions/other/resourceGroups/target"},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{},
+			wantCode:       codes.PermissionDenied,
+		},
+		{
+			name:           "Error: polling error",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "rg", ResourceIds: []string{vnetID}, TargetResourceGroup: "target"},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{beginMove: []any{armresources.ClientMoveResourcesResponse{}, errors.New("error")}},
+			wantState:      pb.OperationState_OPERATION_STATE_FAILED,
+		},
+		{
+			name:           "Success: target name",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: "rg", ResourceIds: []string{vnetID}, TargetResourceGroup: "target"},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{beginMove: []any{armresources.ClientMoveResourcesResponse{}, armresources.ClientMoveResourcesResponse{}}},
+			wantState:      pb.OperationState_OPERATION_STATE_SUCCEEDED,
+			wantMove:       armresources.MoveInfo{Resources: []*string{toPtr(vnetID)}, TargetResourceGroup: toPtr(targetID)},
+		},
+		{
+			name:           "Success: source and target IDs",
+			req:            &pb.MoveResourcesRequest{SourceResourceGroup: rgID, ResourceIds: []string{vnetID}, TargetResourceGroup: targetID},
+			subscriptionID: "subscriptionID",
+			fakeCalls:      &fakeGenericCalls{beginMove: []any{armresources.ClientMoveResourcesResponse{}}},
+			wantState:      pb.OperationState_OPERATION_STATE_SUCCEEDED,
+			wantMove:       armresources.MoveInfo{Resources: []*string{toPtr(vnetID)}, TargetResourceGroup: toPtr(targetID)},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{subscriptionID: test.subscriptionID, genericClient: mustFakeGenericClient(test.fakeCalls), ops: operations{pollFrequency: time.Millisecond}}
+		resp, err := s.MoveResources(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestMoveResources(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if test.wantViolations != nil {
+			var gotViolations []string
+			for _, d := range status.Convert(err).Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					for _, fv := range br.FieldViolations {
+						gotViolations = append(gotViolations, fv.Field)
+					}
+				}
+			}
+			if diff := cmp.Diff(test.wantViolations, gotViolations); diff != "" {
+				t.Errorf("TestMoveResources(%s): field violations -want/+got:\n%s", test.name, diff)
+			}
+		}
+		if err != nil {
+			continue
+		}
+
+		op, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: resp.OperationId})
+		if err != nil {
+			t.Errorf("TestMoveResources(%s): WaitOperation() got err == %s, want err == nil", test.name, err)
+			continue
+		}
+		if op.State != test.wantState {
+			t.Errorf("TestMoveResources(%s): got state %v, want %v", test.name, op.State, test.wantState)
+		}
+		if op.Kind != opMoveResources || op.Target != rgID {
+			t.Errorf("TestMoveResources(%s): got operation %s on %s, want %s on %s", test.name, op.Kind, op.Target, opMoveResources, rgID)
+		}
+		if test.wantState != pb.OperationState_OPERATION_STATE_SUCCEEDED {
+			continue
+		}
+		if diff := cmp.Diff(test.wantMove, test.fakeCalls.gotMoves[0]); diff != "" {
+			t.Errorf("TestMoveResources(%s): move -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestValidateMoveResources(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeGenericCalls
+		wantState pb.OperationState
+	}{
+		{
+			name:      "Cannot be moved",
+			fakeCalls: &fakeGenericCalls{beginValidateMove: []any{armresources.ClientValidateMoveResourcesResponse{}, errors.New("ResourceNotMovable")}},
+			wantState: pb.OperationState_OPERATION_STATE_FAILED,
+		},
+		{
+			name:      "Can be moved",
+			fakeCalls: &fakeGenericCalls{beginValidateMove: []any{armresources.ClientValidateMoveResourcesResponse{}, armresources.ClientValidateMoveResourcesResponse{}}},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{subscriptionID: "subscriptionID", genericClient: mustFakeGenericClient(test.fakeCalls), ops: operations{pollFrequency: time.Millisecond}}
+		req := &pb.MoveResourcesRequest{SourceResourceGroup: "rg", ResourceIds: []string{vnetID}, TargetResourceGroup: "target"}
+		resp, err := s.ValidateMoveResources(context.Background(), req)
+		if err != nil {
+			t.Errorf("TestValidateMoveResources(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		}
+
+		op, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: resp.OperationId})
+		if err != nil {
+			t.Errorf("TestValidateMoveResources(%s): WaitOperation() got err == %s, want err == nil", test.name, err)
+			continue
+		}
+		if op.State != test.wantState {
+			t.Errorf("TestValidateMoveResources(%s): got state %v, want %v: %s", test.name, op.State, test.wantState, op.ErrorMessage)
+		}
+		if op.Kind != opValidateMoveResources {
+			t.Errorf("TestValidateMoveResources(%s): got kind %s, want %s", test.name, op.Kind, opValidateMoveResources)
+		}
+	}
+}
@@ -0,0 +1,451 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+const (
+	// defaultPollFrequency is how often ARM is polled if WithPollFrequency() is not used.
+	defaultPollFrequency = 10 * time.Second
+	// operationRetention is how long an operation is kept after it is done.
+	operationRetention = 24 * time.Hour
+	// pollAttempts is how many times a poll that fails with a transient error is tried before the operation fails.
+	pollAttempts = 5
+	// pollTimeout bounds each poll of ARM.
+	pollTimeout = time.Minute
+)
+
+// lroPoller is a *runtime.Poller[T] with T erased, so that operations of any kind can be tracked the same way.
+type lroPoller interface {
+	Done() bool
+	Poll(ctx context.Context) (*http.Response, error)
+	ResumeToken() (string, error)
+	// result returns the result of the operation. It must only be called once Done() is true.
+	result(ctx context.Context) (*anypb.Any, error)
+}
+
+// armPoller adapts a *runtime.Poller[T] to lroPoller.
+type armPoller[T any] struct {
+	*runtime.Poller[T]
+
+	// convert converts the result of the poller. If nil, the operation has no result.
+	convert func(T) (*anypb.Any, error)
+}
+
+func (a armPoller[T]) result(ctx context.Context) (*anypb.Any, error) {
+	r, err := a.Result(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.convert == nil {
+		return nil, nil
+	}
+	return a.convert(r)
+}
+
+// operation is a long-running operation that is polled in the background, independently of the
+// RPC that started it.
+type operation struct {
+	mu     sync.Mutex
+	op     *pb.Operation
+	cancel context.CancelFunc
+	// resumeToken can be used to recreate the poller with runtime.NewPollerFromResumeToken().
+	resumeToken string
+	done        chan struct{}
+	// changed is closed and replaced each time the operation changes.
+	changed chan struct{}
+	// finished are called when the operation reaches a terminal state, before done is closed.
+	finished []func()
+	// polls are the results of the polls made by this process, so that watchers can report each of them.
+	polls []pollRecord
+}
+
+// pollRecord is the result of one poll of an operation.
+type pollRecord struct {
+	// n is the number of the poll, the operation's Polls once it was recorded.
+	n          int32
+	httpStatus int32
+	at         time.Time
+}
+
+// changes returns a channel that is closed the next time the operation changes.
+func (o *operation) changes() <-chan struct{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.changed == nil {
+		o.changed = make(chan struct{})
+	}
+	return o.changed
+}
+
+// notify wakes everything waiting on changes(). o.mu must be held.
+func (o *operation) notify() {
+	if o.changed != nil {
+		close(o.changed)
+	}
+	o.changed = make(chan struct{})
+}
+
+// proto returns a copy of the current state of the operation.
+func (o *operation) proto() *pb.Operation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.op.CloneVT()
+}
+
+// isDone reports if the operation has reached a terminal state.
+func (o *operation) isDone() bool {
+	select {
+	case <-o.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordPoll records the result of a poll.
+func (o *operation) recordPoll(resp *http.Response) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := time.Now()
+	o.op.Polls++
+	if resp != nil {
+		o.op.LastHTTPStatus = int32(resp.StatusCode)
+	}
+	o.op.UpdateTime = timestamppb.New(now)
+	o.polls = append(o.polls, pollRecord{n: o.op.Polls, httpStatus: o.op.LastHTTPStatus, at: now})
+	o.notify()
+}
+
+// pollsSince returns a copy of the current state of the operation and the records of the polls after poll n.
+func (o *operation) pollsSince(n int32) (*pb.Operation, []pollRecord) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var polls []pollRecord
+	for _, r := range o.polls {
+		if r.n > n {
+			polls = append(polls, r)
+		}
+	}
+	return o.op.CloneVT(), polls
+}
+
+// onFinish calls f when the operation reaches a terminal state, before done is closed. If it already
+// has, f is called now.
+func (o *operation) onFinish(f func()) {
+	o.mu.Lock()
+	if o.op.State == pb.OperationState_OPERATION_STATE_RUNNING {
+		o.finished = append(o.finished, f)
+		o.mu.Unlock()
+		return
+	}
+	o.mu.Unlock()
+	f()
+}
+
+// finish moves the operation to a terminal state. It is a no-op if the operation is already finished.
+// The functions passed to onFinish() are called before done is closed, so that anything waiting on done
+// sees their effects.
+func (o *operation) finish(state pb.OperationState, result *anypb.Any, err error) {
+	o.mu.Lock()
+	if o.op.State != pb.OperationState_OPERATION_STATE_RUNNING {
+		o.mu.Unlock()
+		return
+	}
+	o.op.State = state
+	o.op.Result = result
+	if err != nil {
+		st := status.Convert(azError(err))
+		o.op.ErrorCode = int32(st.Code())
+		o.op.ErrorMessage = st.Message()
+	}
+	o.op.UpdateTime = timestamppb.Now()
+	finished := o.finished
+	o.finished = nil
+	o.mu.Unlock()
+
+	for _, f := range finished {
+		f()
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	close(o.done)
+	o.notify()
+}
+
+// run polls p until it is done or ctx is cancelled. A poll that fails with a transient error is retried,
+// the operation only fails if retries are exhausted or the error is not transient.
+func (o *operation) run(ctx context.Context, p lroPoller, freq time.Duration) {
+	retry := pollRetryPolicy(freq)
+	for !p.Done() {
+		err := retry.do(ctx, func(attemptCtx context.Context) error {
+			resp, err := p.Poll(attemptCtx)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			o.recordPoll(resp)
+			return pollError(err)
+		})
+		if ctx.Err() != nil {
+			// Cancelled via CancelOperation(), which has already recorded the state.
+			return
+		}
+		if err != nil {
+			o.finish(pb.OperationState_OPERATION_STATE_FAILED, nil, err)
+			return
+		}
+		if p.Done() {
+			break
+		}
+
+		timer := time.NewTimer(freq)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	result, err := p.result(ctx)
+	if err != nil {
+		o.finish(pb.OperationState_OPERATION_STATE_FAILED, nil, err)
+		return
+	}
+	o.finish(pb.OperationState_OPERATION_STATE_SUCCEEDED, result, nil)
+}
+
+// pollRetryPolicy returns the RetryPolicy for polls of an operation that is polled every freq.
+func pollRetryPolicy(freq time.Duration) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       pollAttempts,
+		InitialBackoff:    freq,
+		MaxBackoff:        8 * freq,
+		Multiplier:        2,
+		Jitter:            0.2,
+		RetriableCodes:    []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded},
+		PerAttemptTimeout: pollTimeout,
+	}
+}
+
+// pollError converts an error returned by Poll() to a status error. Network errors are Unavailable,
+// so that they are retried like ARM's own transient errors.
+func pollError(err error) error {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return azError(err)
+}
+
+// operations tracks long-running operations. The zero value is ready to use.
+type operations struct {
+	mu  sync.Mutex
+	ops map[string]*operation
+
+	// pollFrequency is how often ARM is polled. If zero, defaultPollFrequency is used.
+	pollFrequency time.Duration
+	// store, if set, persists operations so they survive a restart.
+	store opStore
+}
+
+// newOperation creates a running operation of kind acting on target.
+func newOperation(kind, target string) *operation {
+	now := timestamppb.Now()
+	return &operation{
+		op: &pb.Operation{
+			Id:         newOperationID(),
+			Kind:       kind,
+			Target:     target,
+			State:      pb.OperationState_OPERATION_STATE_RUNNING,
+			CreateTime: now,
+			UpdateTime: now,
+		},
+		cancel: func() {},
+		done:   make(chan struct{}),
+	}
+}
+
+// start begins tracking an operation of kind acting on target that is polled with p.
+func (o *operations) start(kind, target string, p lroPoller) *operation {
+	op := newOperation(kind, target)
+	// A poller that fails to give a resume token can't be recovered after a restart, but can still be tracked.
+	op.resumeToken, _ = p.ResumeToken()
+	o.track(op, p)
+	return op
+}
+
+// track adds op and polls p in the background until the operation is done.
+func (o *operations) track(op *operation, p lroPoller) {
+	ctx, cancel := context.WithCancel(context.Background())
+	op.cancel = cancel
+
+	o.add(op)
+	o.persist(op)
+	op.onFinish(func() { o.persist(op) })
+
+	freq := o.pollFrequency
+	if freq == 0 {
+		freq = defaultPollFrequency
+	}
+	go func() {
+		defer cancel()
+		op.run(ctx, p, freq)
+	}()
+}
+
+// add adds op to the tracked operations.
+func (o *operations) add(op *operation) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.prune()
+	if o.ops == nil {
+		o.ops = map[string]*operation{}
+	}
+	o.ops[op.op.Id] = op
+}
+
+// persist writes op to the store, if there is one. Failures are logged, as the operation is still
+// tracked in memory and will only be lost if the server restarts.
+func (o *operations) persist(op *operation) {
+	if o.store == nil {
+		return
+	}
+
+	op.mu.Lock()
+	rec := opRecord{Op: op.op.CloneVT(), ResumeToken: op.resumeToken}
+	op.mu.Unlock()
+
+	if err := o.store.Put(rec); err != nil {
+		log.Printf("could not persist operation %s: %s", rec.Op.Id, err)
+	}
+}
+
+// recover loads the operations in the store. Operations that were running are resumed with the
+// pollers created by resume. Operations that cannot be resumed are marked as failed.
+func (o *operations) recover(resume func(kind, target, token string) (lroPoller, error)) error {
+	recs, err := o.store.List()
+	if err != nil {
+		return fmt.Errorf("could not read operation store: %w", err)
+	}
+
+	for _, rec := range recs {
+		op := &operation{op: rec.Op, resumeToken: rec.ResumeToken, cancel: func() {}, done: make(chan struct{})}
+		if rec.Op.GetState() != pb.OperationState_OPERATION_STATE_RUNNING {
+			close(op.done)
+			o.add(op)
+			continue
+		}
+
+		p, err := resume(rec.Op.GetKind(), rec.Op.GetTarget(), rec.ResumeToken)
+		if err != nil {
+			o.add(op)
+			op.finish(pb.OperationState_OPERATION_STATE_FAILED, nil, fmt.Errorf("could not resume operation after restart: %w", err))
+			o.persist(op)
+			continue
+		}
+		o.track(op, p)
+	}
+	return nil
+}
+
+// get returns the operation with id.
+func (o *operations) get(id string) (*operation, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	op, ok := o.ops[id]
+	return op, ok
+}
+
+// list returns all operations of kind, or all operations if kind is empty, oldest first.
+func (o *operations) list(kind string) []*pb.Operation {
+	o.mu.Lock()
+	ops := make([]*operation, 0, len(o.ops))
+	for _, op := range o.ops {
+		ops = append(ops, op)
+	}
+	o.mu.Unlock()
+
+	out := make([]*pb.Operation, 0, len(ops))
+	for _, op := range ops {
+		p := op.proto()
+		if kind != "" && p.Kind != kind {
+			continue
+		}
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].CreateTime.AsTime().Before(out[j].CreateTime.AsTime())
+	})
+	return out
+}
+
+// cancel stops polling the operation with id and marks it as cancelled.
+func (o *operations) cancel(id string) (*operation, error) {
+	op, ok := o.get(id)
+	if !ok {
+		return nil, errOperationNotFound
+	}
+	if op.isDone() {
+		return nil, errOperationDone
+	}
+	op.cancel()
+	op.finish(pb.OperationState_OPERATION_STATE_CANCELLED, nil, nil)
+	return op, nil
+}
+
+// prune removes operations that have been done for longer than operationRetention. o.mu must be held.
+func (o *operations) prune() {
+	cutoff := time.Now().Add(-operationRetention)
+	for id, op := range o.ops {
+		if !op.isDone() {
+			continue
+		}
+		if op.proto().UpdateTime.AsTime().Before(cutoff) {
+			delete(o.ops, id)
+			if o.store != nil {
+				if err := o.store.Delete(id); err != nil {
+					log.Printf("could not delete operation %s from store: %s", id, err)
+				}
+			}
+		}
+	}
+}
+
+var (
+	errOperationNotFound = errors.New("operation not found")
+	errOperationDone     = errors.New("operation is already done")
+)
+
+// newOperationID returns a random operation ID.
+func newOperationID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
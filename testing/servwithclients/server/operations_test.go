@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func TestOperations(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{ops: operations{pollFrequency: time.Millisecond}}
+	ctx := context.Background()
+
+	done := s.ops.start("DeleteResourceGroup", "done", &fakePoller{polls: []any{http.StatusAccepted, http.StatusOK}})
+	failed := s.ops.start("DeleteResourceGroup", "failed", &fakePoller{polls: []any{http.StatusAccepted, errors.New("error")}})
+	blocked := s.ops.start("Other", "blocked", &fakePoller{polls: []any{http.StatusOK}, block: make(chan struct{})})
+
+	got, err := s.WaitOperation(ctx, &pb.WaitOperationRequest{Id: done.proto().Id})
+	if err != nil {
+		t.Fatalf("TestOperations: WaitOperation(done) got err == %s, want err == nil", err)
+	}
+	if got.State != pb.OperationState_OPERATION_STATE_SUCCEEDED || got.Polls != 2 || got.LastHTTPStatus != http.StatusOK {
+		t.Errorf("TestOperations: WaitOperation(done) got %v", got)
+	}
+
+	got, err = s.WaitOperation(ctx, &pb.WaitOperationRequest{Id: failed.proto().Id})
+	if err != nil {
+		t.Fatalf("TestOperations: WaitOperation(failed) got err == %s, want err == nil", err)
+	}
+	if got.State != pb.OperationState_OPERATION_STATE_FAILED || got.ErrorCode != int32(codes.Unknown) {
+		t.Errorf("TestOperations: WaitOperation(failed) got %v", got)
+	}
+
+	got, err = s.WaitOperation(ctx, &pb.WaitOperationRequest{Id: blocked.proto().Id, Timeout: durationpb.New(time.Millisecond)})
+	if err != nil {
+		t.Fatalf("TestOperations: WaitOperation(blocked) got err == %s, want err == nil", err)
+	}
+	if got.State != pb.OperationState_OPERATION_STATE_RUNNING {
+		t.Errorf("TestOperations: WaitOperation(blocked) got state %v, want RUNNING", got.State)
+	}
+
+	list, err := s.ListOperations(ctx, &pb.ListOperationsRequest{Kind: "DeleteResourceGroup"})
+	if err != nil {
+		t.Fatalf("TestOperations: ListOperations() got err == %s, want err == nil", err)
+	}
+	if len(list.Operations) != 2 {
+		t.Errorf("TestOperations: ListOperations(DeleteResourceGroup) got %d operations, want 2", len(list.Operations))
+	}
+
+	got, err = s.CancelOperation(ctx, &pb.CancelOperationRequest{Id: blocked.proto().Id})
+	if err != nil {
+		t.Fatalf("TestOperations: CancelOperation(blocked) got err == %s, want err == nil", err)
+	}
+	if got.State != pb.OperationState_OPERATION_STATE_CANCELLED {
+		t.Errorf("TestOperations: CancelOperation(blocked) got state %v, want CANCELLED", got.State)
+	}
+
+	_, err = s.CancelOperation(ctx, &pb.CancelOperationRequest{Id: done.proto().Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TestOperations: CancelOperation(done) got code %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+	_, err = s.GetOperation(ctx, &pb.GetOperationRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("TestOperations: GetOperation(missing) got code %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestOperationPollRetries(t *testing.T) {
+	t.Parallel()
+
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+
+	tests := []struct {
+		name      string
+		polls     []any
+		wantState pb.OperationState
+		wantCode  codes.Code
+		wantPolls int32
+	}{
+		{
+			name:      "Transient errors are retried",
+			polls:     []any{http.StatusAccepted, unavailable, status.Error(codes.ResourceExhausted, "throttled"), http.StatusOK},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+			wantPolls: 4,
+		},
+		{
+			name:      "Network errors are retried",
+			polls:     []any{&net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}, http.StatusOK},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+			wantPolls: 2,
+		},
+		{
+			name:      "Retries are exhausted",
+			polls:     []any{unavailable, unavailable, unavailable, unavailable, unavailable, http.StatusOK},
+			wantState: pb.OperationState_OPERATION_STATE_FAILED,
+			wantCode:  codes.Unavailable,
+			wantPolls: pollAttempts,
+		},
+		{
+			name:      "Other errors are not retried",
+			polls:     []any{status.Error(codes.InvalidArgument, "bad request"), http.StatusOK},
+			wantState: pb.OperationState_OPERATION_STATE_FAILED,
+			wantCode:  codes.InvalidArgument,
+			wantPolls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{ops: operations{pollFrequency: time.Millisecond}}
+		op := s.ops.start("Other", "target", &fakePoller{polls: test.polls})
+
+		got, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: op.proto().Id})
+		if err != nil {
+			t.Errorf("TestOperationPollRetries(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		}
+		if got.State != test.wantState || codes.Code(got.ErrorCode) != test.wantCode || got.Polls != test.wantPolls {
+			t.Errorf("TestOperationPollRetries(%s): got state %v, code %v, %d polls, want %v, %v, %d polls", test.name, got.State, codes.Code(got.ErrorCode), got.Polls, test.wantState, test.wantCode, test.wantPolls)
+		}
+	}
+}
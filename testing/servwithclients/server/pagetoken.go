@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// defaultPageTokenKey signs page tokens when WithPageTokenKey() is not used. Tokens signed with it
+// are only valid for the life of the process.
+var defaultPageTokenKey = func() []byte {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}()
+
+// pageToken records where a list operation stopped so that it can be resumed.
+// It is handed to clients signed so that they cannot alter it.
+type pageToken struct {
+	// Link is the ARM link of the page we stopped in. Empty for the first page.
+	Link string `json:"l,omitempty"`
+	// Offset is the index of the next item to return in the page at Link.
+	Offset int `json:"o,omitempty"`
+	// Filter identifies the listing that created the token, such as the listFilter.hash() of the request.
+	Filter string `json:"f,omitempty"`
+}
+
+// pageSize validates and normalizes a requested page size.
+func pageSize(size int32) (int, error) {
+	switch {
+	case size < 0:
+		return 0, errors.New("PageSize cannot be negative")
+	case size == 0:
+		return defaultPageSize, nil
+	case size > maxPageSize:
+		return maxPageSize, nil
+	}
+	return int(size), nil
+}
+
+// tokenKey returns the key used to sign page tokens.
+func (s *Server) tokenKey() []byte {
+	if s.pageTokenKey == nil {
+		return defaultPageTokenKey
+	}
+	return s.pageTokenKey
+}
+
+// encodePageToken encodes and signs t.
+func (s *Server) encodePageToken(t pageToken) (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload)), nil
+}
+
+// decodePageToken verifies the signature of a token created by encodePageToken() and decodes it.
+func (s *Server) decodePageToken(token string) (pageToken, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return pageToken{}, errors.New("malformed page token")
+	}
+	gotSig, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return pageToken{}, errors.New("malformed page token")
+	}
+	if !hmac.Equal(gotSig, s.sign(payload)) {
+		return pageToken{}, errors.New("invalid page token")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return pageToken{}, errors.New("malformed page token")
+	}
+
+	t := pageToken{}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return pageToken{}, errors.New("malformed page token")
+	}
+	if t.Offset < 0 {
+		return pageToken{}, errors.New("invalid page token")
+	}
+	return t, nil
+}
+
+func (s *Server) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.tokenKey())
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// resumePager sets pager to resume at the ARM page at link. If link is empty, pager is returned unchanged.
+func resumePager[T any](ctx context.Context, pager *runtime.Pager[T], link string) (*runtime.Pager[T], error) {
+	if link == "" {
+		return pager, nil
+	}
+
+	// A Pager can be primed with a previous page. We give it a page that only has a nextLink,
+	// so the first page returned is our empty primer and the page after that is fetched from link.
+	b, err := json.Marshal(map[string]string{"nextLink": link})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, pager); err != nil {
+		return nil, err
+	}
+	if _, err := pager.NextPage(ctx); err != nil {
+		return nil, err
+	}
+	return pager, nil
+}
+
+// requestPageToken decodes token, the PageToken of a request, and checks that it was created for filter.
+// An empty token starts at the first page of filter.
+func (s *Server) requestPageToken(token, filter string) (pageToken, error) {
+	if token == "" {
+		return pageToken{Filter: filter}, nil
+	}
+	tok, err := s.decodePageToken(token)
+	if err != nil {
+		return pageToken{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if tok.Filter != filter {
+		return pageToken{}, status.Error(codes.InvalidArgument, "PageToken was created for a different request")
+	}
+	return tok, nil
+}
+
+// readPage reads up to size items from pager, starting where tok stopped. page returns the items and
+// the next link of an ARM page; it may leave out items that should not be listed. Offsets index the items
+// page returns, so it must return the same items each time it is given the same ARM page. If there are
+// more items, the returned token resumes after the last item returned, with the Filter of tok.
+func readPage[P, T any](ctx context.Context, pager *runtime.Pager[P], tok pageToken, size int, page func(P) ([]*T, *string)) ([]*T, *pageToken, error) {
+	pager, err := resumePager(ctx, pager, tok.Link)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var items []*T
+	link, skip := tok.Link, tok.Offset
+	for pager.More() {
+		p, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, nil, azError(err)
+		}
+		values, nextLink := page(p)
+		for i, v := range values {
+			if i < skip {
+				continue
+			}
+			if len(items) == size {
+				return items, &pageToken{Link: link, Offset: i, Filter: tok.Filter}, nil
+			}
+			if v == nil {
+				continue
+			}
+			items = append(items, v)
+		}
+		skip = 0
+		link = deref(nextLink)
+		if len(items) == size && link != "" {
+			return items, &pageToken{Link: link, Filter: tok.Filter}, nil
+		}
+	}
+	return items, nil, nil
+}
+
+// nextPageToken encodes next, if there is a next page.
+func (s *Server) nextPageToken(next *pageToken) (string, error) {
+	if next == nil {
+		return "", nil
+	}
+	tok, err := s.encodePageToken(*next)
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return tok, nil
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPageToken(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{}
+	want := pageToken{Link: "https://management.azure.com/subscriptions/sub/resourcegroups?$skiptoken=abc", Offset: 3}
+
+	tok, err := s.encodePageToken(want)
+	if err != nil {
+		t.Fatalf("TestPageToken: encodePageToken() got err == %s, want err == nil", err)
+	}
+	got, err := s.decodePageToken(tok)
+	if err != nil {
+		t.Fatalf("TestPageToken: decodePageToken() got err == %s, want err == nil", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("TestPageToken: -want/+got:\n%s", diff)
+	}
+
+	other := &Server{pageTokenKey: []byte("another key that is 32 bytes long")}
+	if _, err := other.decodePageToken(tok); err == nil {
+		t.Errorf("TestPageToken: decodePageToken() with a different key: got err == nil, want err != nil")
+	}
+
+	tampered := []byte(tok)
+	tampered[0] ^= 1
+	if _, err := s.decodePageToken(string(tampered)); err == nil {
+		t.Errorf("TestPageToken: decodePageToken() with altered token: got err == nil, want err != nil")
+	}
+	if _, err := s.decodePageToken("garbage"); err == nil {
+		t.Errorf("TestPageToken: decodePageToken(garbage): got err == nil, want err != nil")
+	}
+}
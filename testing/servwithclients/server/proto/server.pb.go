@@ -10,6 +10,10 @@ import (
 	proto "github.com/element-of-surprise/examples/testing/servwithclients/proto/greeter/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +25,170 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TagOperation details how the Tags in an update are applied to the existing tags.
+type TagOperation int32
+
+const (
+	// The operation was not set. Requests that update tags are rejected with InvalidArgument.
+	TagOperation_TAG_OPERATION_UNSPECIFIED TagOperation = 0
+	// Tags are added to the existing tags, overwriting the values of existing keys.
+	TagOperation_TAG_OPERATION_MERGE TagOperation = 1
+	// Tags replace all existing tags. An empty Tags removes all tags.
+	TagOperation_TAG_OPERATION_REPLACE TagOperation = 2
+	// The keys in Tags are removed from the existing tags. Values are ignored.
+	TagOperation_TAG_OPERATION_DELETE TagOperation = 3
+)
+
+// Enum value maps for TagOperation.
+var (
+	TagOperation_name = map[int32]string{
+		0: "TAG_OPERATION_UNSPECIFIED",
+		1: "TAG_OPERATION_MERGE",
+		2: "TAG_OPERATION_REPLACE",
+		3: "TAG_OPERATION_DELETE",
+	}
+	TagOperation_value = map[string]int32{
+		"TAG_OPERATION_UNSPECIFIED": 0,
+		"TAG_OPERATION_MERGE":       1,
+		"TAG_OPERATION_REPLACE":     2,
+		"TAG_OPERATION_DELETE":      3,
+	}
+)
+
+func (x TagOperation) Enum() *TagOperation {
+	p := new(TagOperation)
+	*p = x
+	return p
+}
+
+func (x TagOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes[0].Descriptor()
+}
+
+func (TagOperation) Type() protoreflect.EnumType {
+	return &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes[0]
+}
+
+func (x TagOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagOperation.Descriptor instead.
+func (TagOperation) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{0}
+}
+
+type DeploymentMode int32
+
+const (
+	// DEPLOYMENT_MODE_UNSPECIFIED uses ARM's default, DEPLOYMENT_MODE_INCREMENTAL.
+	DeploymentMode_DEPLOYMENT_MODE_UNSPECIFIED DeploymentMode = 0
+	// DEPLOYMENT_MODE_INCREMENTAL leaves resources that are not in the template unchanged.
+	DeploymentMode_DEPLOYMENT_MODE_INCREMENTAL DeploymentMode = 1
+	// DEPLOYMENT_MODE_COMPLETE deletes resources in the resource group that are not in the template.
+	DeploymentMode_DEPLOYMENT_MODE_COMPLETE DeploymentMode = 2
+)
+
+// Enum value maps for DeploymentMode.
+var (
+	DeploymentMode_name = map[int32]string{
+		0: "DEPLOYMENT_MODE_UNSPECIFIED",
+		1: "DEPLOYMENT_MODE_INCREMENTAL",
+		2: "DEPLOYMENT_MODE_COMPLETE",
+	}
+	DeploymentMode_value = map[string]int32{
+		"DEPLOYMENT_MODE_UNSPECIFIED": 0,
+		"DEPLOYMENT_MODE_INCREMENTAL": 1,
+		"DEPLOYMENT_MODE_COMPLETE":    2,
+	}
+)
+
+func (x DeploymentMode) Enum() *DeploymentMode {
+	p := new(DeploymentMode)
+	*p = x
+	return p
+}
+
+func (x DeploymentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeploymentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes[1].Descriptor()
+}
+
+func (DeploymentMode) Type() protoreflect.EnumType {
+	return &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes[1]
+}
+
+func (x DeploymentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeploymentMode.Descriptor instead.
+func (DeploymentMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{1}
+}
+
+type OperationState int32
+
+const (
+	OperationState_OPERATION_STATE_UNSPECIFIED OperationState = 0
+	OperationState_OPERATION_STATE_RUNNING     OperationState = 1
+	OperationState_OPERATION_STATE_SUCCEEDED   OperationState = 2
+	OperationState_OPERATION_STATE_FAILED      OperationState = 3
+	OperationState_OPERATION_STATE_CANCELLED   OperationState = 4
+)
+
+// Enum value maps for OperationState.
+var (
+	OperationState_name = map[int32]string{
+		0: "OPERATION_STATE_UNSPECIFIED",
+		1: "OPERATION_STATE_RUNNING",
+		2: "OPERATION_STATE_SUCCEEDED",
+		3: "OPERATION_STATE_FAILED",
+		4: "OPERATION_STATE_CANCELLED",
+	}
+	OperationState_value = map[string]int32{
+		"OPERATION_STATE_UNSPECIFIED": 0,
+		"OPERATION_STATE_RUNNING":     1,
+		"OPERATION_STATE_SUCCEEDED":   2,
+		"OPERATION_STATE_FAILED":      3,
+		"OPERATION_STATE_CANCELLED":   4,
+	}
+)
+
+func (x OperationState) Enum() *OperationState {
+	p := new(OperationState)
+	*p = x
+	return p
+}
+
+func (x OperationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes[2].Descriptor()
+}
+
+func (OperationState) Type() protoreflect.EnumType {
+	return &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes[2]
+}
+
+func (x OperationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationState.Descriptor instead.
+func (OperationState) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{2}
+}
+
 // The request message containing the user's name.
 type HelloRequest struct {
 	state         protoimpl.MessageState
@@ -209,8 +377,11 @@ type CreateResourceGroupRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Region string `protobuf:"bytes,2,opt,name=Region,proto3" json:"Region,omitempty"`
+	Name   string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Region string            `protobuf:"bytes,2,opt,name=Region,proto3" json:"Region,omitempty"`
+	Tags   map[string]string `protobuf:"bytes,3,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Subscription is the subscription to act on. If empty, the server's default subscription is used.
+	Subscription string `protobuf:"bytes,4,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
 }
 
 func (x *CreateResourceGroupRequest) Reset() {
@@ -259,6 +430,20 @@ func (x *CreateResourceGroupRequest) GetRegion() string {
 	return ""
 }
 
+func (x *CreateResourceGroupRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateResourceGroupRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
 type CreateResourceGroupReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -311,7 +496,14 @@ type ReadResourceGroupRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Id is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
 	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in Id or the server's default
+	// subscription is used. If Id is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// BypassCache reads the resource group from ARM even if the server has it cached. The cache
+	// is refreshed with the result. It has no effect if the server does not cache resource groups.
+	BypassCache bool `protobuf:"varint,3,opt,name=BypassCache,proto3" json:"BypassCache,omitempty"`
 }
 
 func (x *ReadResourceGroupRequest) Reset() {
@@ -353,12 +545,27 @@ func (x *ReadResourceGroupRequest) GetId() string {
 	return ""
 }
 
+func (x *ReadResourceGroupRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ReadResourceGroupRequest) GetBypassCache() bool {
+	if x != nil {
+		return x.BypassCache
+	}
+	return false
+}
+
 type ReadResourceGroupReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Status        string         `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	ResourceGroup *ResourceGroup `protobuf:"bytes,2,opt,name=ResourceGroup,proto3" json:"ResourceGroup,omitempty"`
 }
 
 func (x *ReadResourceGroupReply) Reset() {
@@ -400,18 +607,27 @@ func (x *ReadResourceGroupReply) GetStatus() string {
 	return ""
 }
 
-type UpdateResourceGroupRequest struct {
+func (x *ReadResourceGroupReply) GetResourceGroup() *ResourceGroup {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return nil
+}
+
+type ResourceGroupExistsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Region string `protobuf:"bytes,3,opt,name=Region,proto3" json:"Region,omitempty"`
+	// Id is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in Id or the server's default
+	// subscription is used. If Id is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
 }
 
-func (x *UpdateResourceGroupRequest) Reset() {
-	*x = UpdateResourceGroupRequest{}
+func (x *ResourceGroupExistsRequest) Reset() {
+	*x = ResourceGroupExistsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,13 +635,13 @@ func (x *UpdateResourceGroupRequest) Reset() {
 	}
 }
 
-func (x *UpdateResourceGroupRequest) String() string {
+func (x *ResourceGroupExistsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceGroupRequest) ProtoMessage() {}
+func (*ResourceGroupExistsRequest) ProtoMessage() {}
 
-func (x *UpdateResourceGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *ResourceGroupExistsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -437,42 +653,35 @@ func (x *UpdateResourceGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateResourceGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceGroupExistsRequest.ProtoReflect.Descriptor instead.
+func (*ResourceGroupExistsRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateResourceGroupRequest) GetId() string {
+func (x *ResourceGroupExistsRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateResourceGroupRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateResourceGroupRequest) GetRegion() string {
+func (x *ResourceGroupExistsRequest) GetSubscription() string {
 	if x != nil {
-		return x.Region
+		return x.Subscription
 	}
 	return ""
 }
 
-type UpdateResourceGroupReply struct {
+type ResourceGroupExistsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Exists bool `protobuf:"varint,1,opt,name=Exists,proto3" json:"Exists,omitempty"`
 }
 
-func (x *UpdateResourceGroupReply) Reset() {
-	*x = UpdateResourceGroupReply{}
+func (x *ResourceGroupExistsReply) Reset() {
+	*x = ResourceGroupExistsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,13 +689,13 @@ func (x *UpdateResourceGroupReply) Reset() {
 	}
 }
 
-func (x *UpdateResourceGroupReply) String() string {
+func (x *ResourceGroupExistsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResourceGroupReply) ProtoMessage() {}
+func (*ResourceGroupExistsReply) ProtoMessage() {}
 
-func (x *UpdateResourceGroupReply) ProtoReflect() protoreflect.Message {
+func (x *ResourceGroupExistsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -498,28 +707,46 @@ func (x *UpdateResourceGroupReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResourceGroupReply.ProtoReflect.Descriptor instead.
-func (*UpdateResourceGroupReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceGroupExistsReply.ProtoReflect.Descriptor instead.
+func (*ResourceGroupExistsReply) Descriptor() ([]byte, []int) {
 	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateResourceGroupReply) GetStatus() string {
+func (x *ResourceGroupExistsReply) GetExists() bool {
 	if x != nil {
-		return x.Status
+		return x.Exists
 	}
-	return ""
+	return false
 }
 
-type DeleteResourceGroupRequest struct {
+type UpdateResourceGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Id is not used, the resource group is identified by Name.
+	//
+	// Deprecated: Marked as deprecated in github.com/element-of-surprise/examples/testing/servwithclients/server/proto/server.proto.
 	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Name is the name of the resource group to update.
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Region is immutable and cannot be named in UpdateMask.
+	Region string            `protobuf:"bytes,3,opt,name=Region,proto3" json:"Region,omitempty"`
+	Tags   map[string]string `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// TagOperation is how Tags are applied to the existing tags. It is required if UpdateMask names "Tags".
+	// TAG_OPERATION_MERGE and TAG_OPERATION_DELETE read the existing tags and write back the result without
+	// a concurrency check, so tags written by someone else in between are lost.
+	TagOperation TagOperation `protobuf:"varint,5,opt,name=TagOperation,proto3,enum=service.TagOperation" json:"TagOperation,omitempty"`
+	ManagedBy    string       `protobuf:"bytes,6,opt,name=ManagedBy,proto3" json:"ManagedBy,omitempty"`
+	// UpdateMask names the fields of this message that are patched. Only "Tags" and "ManagedBy"
+	// may be updated and at least one must be given.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+	// Subscription is the subscription to act on. If empty, the server's default subscription is used.
+	Subscription string `protobuf:"bytes,8,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
 }
 
-func (x *DeleteResourceGroupRequest) Reset() {
-	*x = DeleteResourceGroupRequest{}
+func (x *UpdateResourceGroupRequest) Reset() {
+	*x = UpdateResourceGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,13 +754,13 @@ func (x *DeleteResourceGroupRequest) Reset() {
 	}
 }
 
-func (x *DeleteResourceGroupRequest) String() string {
+func (x *UpdateResourceGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceGroupRequest) ProtoMessage() {}
+func (*UpdateResourceGroupRequest) ProtoMessage() {}
 
-func (x *DeleteResourceGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateResourceGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,28 +772,79 @@ func (x *DeleteResourceGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceGroupRequest.ProtoReflect.Descriptor instead.
-func (*DeleteResourceGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateResourceGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceGroupRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteResourceGroupRequest) GetId() string {
+// Deprecated: Marked as deprecated in github.com/element-of-surprise/examples/testing/servwithclients/server/proto/server.proto.
+func (x *UpdateResourceGroupRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteResourceGroupReply struct {
+func (x *UpdateResourceGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateResourceGroupRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *UpdateResourceGroupRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateResourceGroupRequest) GetTagOperation() TagOperation {
+	if x != nil {
+		return x.TagOperation
+	}
+	return TagOperation_TAG_OPERATION_UNSPECIFIED
+}
+
+func (x *UpdateResourceGroupRequest) GetManagedBy() string {
+	if x != nil {
+		return x.ManagedBy
+	}
+	return ""
+}
+
+func (x *UpdateResourceGroupRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateResourceGroupRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+type UpdateResourceGroupReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Status        string         `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	ResourceGroup *ResourceGroup `protobuf:"bytes,2,opt,name=ResourceGroup,proto3" json:"ResourceGroup,omitempty"`
 }
 
-func (x *DeleteResourceGroupReply) Reset() {
-	*x = DeleteResourceGroupReply{}
+func (x *UpdateResourceGroupReply) Reset() {
+	*x = UpdateResourceGroupReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,13 +852,13 @@ func (x *DeleteResourceGroupReply) Reset() {
 	}
 }
 
-func (x *DeleteResourceGroupReply) String() string {
+func (x *UpdateResourceGroupReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceGroupReply) ProtoMessage() {}
+func (*UpdateResourceGroupReply) ProtoMessage() {}
 
-func (x *DeleteResourceGroupReply) ProtoReflect() protoreflect.Message {
+func (x *UpdateResourceGroupReply) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,28 +870,39 @@ func (x *DeleteResourceGroupReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceGroupReply.ProtoReflect.Descriptor instead.
-func (*DeleteResourceGroupReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateResourceGroupReply.ProtoReflect.Descriptor instead.
+func (*UpdateResourceGroupReply) Descriptor() ([]byte, []int) {
 	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteResourceGroupReply) GetStatus() string {
+func (x *UpdateResourceGroupReply) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type ListResourceGroupsRequest struct {
+func (x *UpdateResourceGroupReply) GetResourceGroup() *ResourceGroup {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return nil
+}
+
+type DeleteResourceGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Id is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in Id or the server's default
+	// subscription is used. If Id is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
 }
 
-func (x *ListResourceGroupsRequest) Reset() {
-	*x = ListResourceGroupsRequest{}
+func (x *DeleteResourceGroupRequest) Reset() {
+	*x = DeleteResourceGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,13 +910,13 @@ func (x *ListResourceGroupsRequest) Reset() {
 	}
 }
 
-func (x *ListResourceGroupsRequest) String() string {
+func (x *DeleteResourceGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourceGroupsRequest) ProtoMessage() {}
+func (*DeleteResourceGroupRequest) ProtoMessage() {}
 
-func (x *ListResourceGroupsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteResourceGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,28 +928,37 @@ func (x *ListResourceGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourceGroupsRequest.ProtoReflect.Descriptor instead.
-func (*ListResourceGroupsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteResourceGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceGroupRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListResourceGroupsRequest) GetName() string {
+func (x *DeleteResourceGroupRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-type ListResourceGroupsReply struct {
+func (x *DeleteResourceGroupRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+type DeleteResourceGroupReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResourceGroups []*ResourceGroup `protobuf:"bytes,1,rep,name=resourceGroups,proto3" json:"resourceGroups,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation deleting the resource group.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
 }
 
-func (x *ListResourceGroupsReply) Reset() {
-	*x = ListResourceGroupsReply{}
+func (x *DeleteResourceGroupReply) Reset() {
+	*x = DeleteResourceGroupReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -668,13 +966,13 @@ func (x *ListResourceGroupsReply) Reset() {
 	}
 }
 
-func (x *ListResourceGroupsReply) String() string {
+func (x *DeleteResourceGroupReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourceGroupsReply) ProtoMessage() {}
+func (*DeleteResourceGroupReply) ProtoMessage() {}
 
-func (x *ListResourceGroupsReply) ProtoReflect() protoreflect.Message {
+func (x *DeleteResourceGroupReply) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,30 +984,43 @@ func (x *ListResourceGroupsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourceGroupsReply.ProtoReflect.Descriptor instead.
-func (*ListResourceGroupsReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteResourceGroupReply.ProtoReflect.Descriptor instead.
+func (*DeleteResourceGroupReply) Descriptor() ([]byte, []int) {
 	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListResourceGroupsReply) GetResourceGroups() []*ResourceGroup {
+func (x *DeleteResourceGroupReply) GetStatus() string {
 	if x != nil {
-		return x.ResourceGroups
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type ResourceGroup struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *DeleteResourceGroupReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type ExportResourceGroupTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Region string `protobuf:"bytes,3,opt,name=Region,proto3" json:"Region,omitempty"`
+	// Id is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in Id or the server's default
+	// subscription is used. If Id is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// IncludeParameterDefaultValues sets the current values as the defaults of the template's parameters.
+	IncludeParameterDefaultValues bool `protobuf:"varint,3,opt,name=IncludeParameterDefaultValues,proto3" json:"IncludeParameterDefaultValues,omitempty"`
+	// IncludeComments adds comments to the template.
+	IncludeComments bool `protobuf:"varint,4,opt,name=IncludeComments,proto3" json:"IncludeComments,omitempty"`
 }
 
-func (x *ResourceGroup) Reset() {
-	*x = ResourceGroup{}
+func (x *ExportResourceGroupTemplateRequest) Reset() {
+	*x = ExportResourceGroupTemplateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -717,228 +1028,5174 @@ func (x *ResourceGroup) Reset() {
 	}
 }
 
-func (x *ResourceGroup) String() string {
+func (x *ExportResourceGroupTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceGroup) ProtoMessage() {}
+func (*ExportResourceGroupTemplateRequest) ProtoMessage() {}
 
-func (x *ResourceGroup) ProtoReflect() protoreflect.Message {
+func (x *ExportResourceGroupTemplateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ResourceGroup.ProtoReflect.Descriptor instead.
-func (*ResourceGroup) Descriptor() ([]byte, []int) {
-	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ResourceGroup) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ResourceGroup) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ResourceGroup) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
-}
-
-var File_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto protoreflect.FileDescriptor
-
-var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDesc = []byte{
-	0x0a, 0x59, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x6f, 0x66, 0x2d, 0x73, 0x75, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
-	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x77, 0x69, 0x74, 0x68, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x1a, 0x60, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x6f, 0x66, 0x2d, 0x73, 0x75, 0x72, 0x70,
-	0x72, 0x69, 0x73, 0x65, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x74, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x77, 0x69, 0x74, 0x68, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x65, 0x65,
-	0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x26, 0x0a, 0x0a, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x65, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72,
-	0x65, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x7a, 0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x7a, 0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x22, 0x32, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x64, 0x22, 0x30, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x58, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a,
-	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x22,
-	0x32, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
-	0x4b, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x32, 0x9b, 0x04, 0x0a,
-	0x03, 0x52, 0x50, 0x43, 0x12, 0x38, 0x0a, 0x08, 0x53, 0x61, 0x79, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
-	0x12, 0x15, 0x2e, 0x67, 0x72, 0x65, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67, 0x72, 0x65, 0x65, 0x74, 0x65,
-	0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x12,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2d, 0x6f, 0x66, 0x2d, 0x73, 0x75, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x77, 0x69, 0x74, 0x68, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
-}
-
-var (
-	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescOnce sync.Once
-	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData = file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDesc
-)
-
-func file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP() []byte {
-	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescOnce.Do(func() {
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData)
-	})
-	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData
-}
-
-var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_goTypes = []interface{}{
-	(*HelloRequest)(nil),               // 0: service.HelloRequest
-	(*HelloReply)(nil),                 // 1: service.HelloReply
-	(*Address)(nil),                    // 2: service.Address
-	(*CreateResourceGroupRequest)(nil), // 3: service.CreateResourceGroupRequest
-	(*CreateResourceGroupReply)(nil),   // 4: service.CreateResourceGroupReply
-	(*ReadResourceGroupRequest)(nil),   // 5: service.ReadResourceGroupRequest
-	(*ReadResourceGroupReply)(nil),     // 6: service.ReadResourceGroupReply
-	(*UpdateResourceGroupRequest)(nil), // 7: service.UpdateResourceGroupRequest
-	(*UpdateResourceGroupReply)(nil),   // 8: service.UpdateResourceGroupReply
-	(*DeleteResourceGroupRequest)(nil), // 9: service.DeleteResourceGroupRequest
-	(*DeleteResourceGroupReply)(nil),   // 10: service.DeleteResourceGroupReply
-	(*ListResourceGroupsRequest)(nil),  // 11: service.ListResourceGroupsRequest
-	(*ListResourceGroupsReply)(nil),    // 12: service.ListResourceGroupsReply
-	(*ResourceGroup)(nil),              // 13: service.ResourceGroup
-	(*proto.HelloRequest)(nil),         // 14: greeter.HelloRequest
-	(*proto.HelloReply)(nil),           // 15: greeter.HelloReply
-}
-var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_depIdxs = []int32{
-	2,  // 0: service.HelloRequest.address:type_name -> service.Address
-	13, // 1: service.ListResourceGroupsReply.resourceGroups:type_name -> service.ResourceGroup
-	14, // 2: service.RPC.SayHello:input_type -> greeter.HelloRequest
-	3,  // 3: service.RPC.CreateResourceGroup:input_type -> service.CreateResourceGroupRequest
-	5,  // 4: service.RPC.ReadResourceGroup:input_type -> service.ReadResourceGroupRequest
-	7,  // 5: service.RPC.UpdateResourceGroup:input_type -> service.UpdateResourceGroupRequest
-	9,  // 6: service.RPC.DeleteResourceGroup:input_type -> service.DeleteResourceGroupRequest
-	11, // 7: service.RPC.ListResourceGroups:input_type -> service.ListResourceGroupsRequest
-	15, // 8: service.RPC.SayHello:output_type -> greeter.HelloReply
-	4,  // 9: service.RPC.CreateResourceGroup:output_type -> service.CreateResourceGroupReply
-	6,  // 10: service.RPC.ReadResourceGroup:output_type -> service.ReadResourceGroupReply
-	8,  // 11: service.RPC.UpdateResourceGroup:output_type -> service.UpdateResourceGroupReply
-	10, // 12: service.RPC.DeleteResourceGroup:output_type -> service.DeleteResourceGroupReply
-	12, // 13: service.RPC.ListResourceGroups:output_type -> service.ListResourceGroupsReply
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
-}
-
-func init() {
-	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_init()
-}
-func file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_init() {
-	if File_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelloRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourceGroupTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ExportResourceGroupTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportResourceGroupTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportResourceGroupTemplateRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ExportResourceGroupTemplateRequest) GetIncludeParameterDefaultValues() bool {
+	if x != nil {
+		return x.IncludeParameterDefaultValues
+	}
+	return false
+}
+
+func (x *ExportResourceGroupTemplateRequest) GetIncludeComments() bool {
+	if x != nil {
+		return x.IncludeComments
+	}
+	return false
+}
+
+type ExportResourceGroupTemplateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OperationId is the ID of the long-running operation that did the export.
+	OperationId string `protobuf:"bytes,1,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+	// Template is the ARM template as JSON.
+	Template string `protobuf:"bytes,2,opt,name=Template,proto3" json:"Template,omitempty"`
+	// Errors are the problems ARM had exporting some resources. If set, Template is incomplete.
+	Errors []*ExportError `protobuf:"bytes,3,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *ExportResourceGroupTemplateReply) Reset() {
+	*x = ExportResourceGroupTemplateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResourceGroupTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResourceGroupTemplateReply) ProtoMessage() {}
+
+func (x *ExportResourceGroupTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourceGroupTemplateReply.ProtoReflect.Descriptor instead.
+func (*ExportResourceGroupTemplateReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportResourceGroupTemplateReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *ExportResourceGroupTemplateReply) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *ExportResourceGroupTemplateReply) GetErrors() []*ExportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ExportError is a problem exporting a resource to a template.
+type ExportError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Target is usually the ID of the resource that could not be exported.
+	Target string `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+}
+
+func (x *ExportError) Reset() {
+	*x = ExportError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportError) ProtoMessage() {}
+
+func (x *ExportError) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportError.ProtoReflect.Descriptor instead.
+func (*ExportError) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ExportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportError) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// DeleteResourceGroupEvent reports the progress of a delete.
+type DeleteResourceGroupEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId string         `protobuf:"bytes,1,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+	State       OperationState `protobuf:"varint,2,opt,name=State,proto3,enum=service.OperationState" json:"State,omitempty"`
+	// Poll is the number of the poll of ARM this event is for. There is an event for every poll. The first
+	// event, sent when the delete starts, has a Poll of 0.
+	Poll int32 `protobuf:"varint,3,opt,name=Poll,proto3" json:"Poll,omitempty"`
+	// HTTPStatus is the HTTP status of the poll.
+	HTTPStatus int32 `protobuf:"varint,4,opt,name=HTTPStatus,proto3" json:"HTTPStatus,omitempty"`
+	// Elapsed is the time from the start of the delete to the poll.
+	Elapsed *durationpb.Duration `protobuf:"bytes,5,opt,name=Elapsed,proto3" json:"Elapsed,omitempty"`
+	// ErrorCode and ErrorMessage are the gRPC code and message if State is FAILED.
+	ErrorCode    int32  `protobuf:"varint,6,opt,name=ErrorCode,proto3" json:"ErrorCode,omitempty"`
+	ErrorMessage string `protobuf:"bytes,7,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+}
+
+func (x *DeleteResourceGroupEvent) Reset() {
+	*x = DeleteResourceGroupEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResourceGroupEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceGroupEvent) ProtoMessage() {}
+
+func (x *DeleteResourceGroupEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceGroupEvent.ProtoReflect.Descriptor instead.
+func (*DeleteResourceGroupEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteResourceGroupEvent) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *DeleteResourceGroupEvent) GetState() OperationState {
+	if x != nil {
+		return x.State
+	}
+	return OperationState_OPERATION_STATE_UNSPECIFIED
+}
+
+func (x *DeleteResourceGroupEvent) GetPoll() int32 {
+	if x != nil {
+		return x.Poll
+	}
+	return 0
+}
+
+func (x *DeleteResourceGroupEvent) GetHTTPStatus() int32 {
+	if x != nil {
+		return x.HTTPStatus
+	}
+	return 0
+}
+
+func (x *DeleteResourceGroupEvent) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+func (x *DeleteResourceGroupEvent) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *DeleteResourceGroupEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListResourceGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name filters by resource group name. If it contains any of "*?[" it is matched as a glob,
+	// otherwise it is matched as a prefix. Matching is case-insensitive.
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// PageSize is the maximum number of resource groups to return. If 0, a default of 100 is used.
+	// Values above 1000 are set to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// PageToken is the NextPageToken from a previous ListResourceGroupsReply. It is used to resume listing.
+	PageToken string `protobuf:"bytes,3,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	// Region filters by the location of the resource group.
+	Region string `protobuf:"bytes,4,opt,name=Region,proto3" json:"Region,omitempty"`
+	// TagName filters by resource groups that have this tag.
+	TagName string `protobuf:"bytes,5,opt,name=TagName,proto3" json:"TagName,omitempty"`
+	// TagValue filters by resource groups where TagName has this value. Requires TagName.
+	TagValue string `protobuf:"bytes,6,opt,name=TagValue,proto3" json:"TagValue,omitempty"`
+	// Subscription is the subscription to act on. If empty, the server's default subscription is used.
+	Subscription string `protobuf:"bytes,7,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// BypassCache lists the resource groups from ARM even if the server has the page cached. The cache
+	// is refreshed with the result. It has no effect if the server does not cache resource groups.
+	BypassCache bool `protobuf:"varint,8,opt,name=BypassCache,proto3" json:"BypassCache,omitempty"`
+}
+
+func (x *ListResourceGroupsRequest) Reset() {
+	*x = ListResourceGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourceGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceGroupsRequest) ProtoMessage() {}
+
+func (x *ListResourceGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListResourceGroupsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListResourceGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResourceGroupsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListResourceGroupsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListResourceGroupsRequest) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *ListResourceGroupsRequest) GetTagValue() string {
+	if x != nil {
+		return x.TagValue
+	}
+	return ""
+}
+
+func (x *ListResourceGroupsRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ListResourceGroupsRequest) GetBypassCache() bool {
+	if x != nil {
+		return x.BypassCache
+	}
+	return false
+}
+
+type ListResourceGroupsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceGroups []*ResourceGroup `protobuf:"bytes,1,rep,name=resourceGroups,proto3" json:"resourceGroups,omitempty"`
+	// NextPageToken is set if there are more resource groups. Pass it as PageToken to get the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *ListResourceGroupsReply) Reset() {
+	*x = ListResourceGroupsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourceGroupsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceGroupsReply) ProtoMessage() {}
+
+func (x *ListResourceGroupsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceGroupsReply.ProtoReflect.Descriptor instead.
+func (*ListResourceGroupsReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListResourceGroupsReply) GetResourceGroups() []*ResourceGroup {
+	if x != nil {
+		return x.ResourceGroups
+	}
+	return nil
+}
+
+func (x *ListResourceGroupsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// StreamResourceGroupsRequest has the same filters as ListResourceGroupsRequest.
+type StreamResourceGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Region   string `protobuf:"bytes,2,opt,name=Region,proto3" json:"Region,omitempty"`
+	TagName  string `protobuf:"bytes,3,opt,name=TagName,proto3" json:"TagName,omitempty"`
+	TagValue string `protobuf:"bytes,4,opt,name=TagValue,proto3" json:"TagValue,omitempty"`
+	// Subscription is the subscription to act on. If empty, the server's default subscription is used.
+	Subscription string `protobuf:"bytes,5,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+}
+
+func (x *StreamResourceGroupsRequest) Reset() {
+	*x = StreamResourceGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResourceGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResourceGroupsRequest) ProtoMessage() {}
+
+func (x *StreamResourceGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResourceGroupsRequest.ProtoReflect.Descriptor instead.
+func (*StreamResourceGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamResourceGroupsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamResourceGroupsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StreamResourceGroupsRequest) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *StreamResourceGroupsRequest) GetTagValue() string {
+	if x != nil {
+		return x.TagValue
+	}
+	return ""
+}
+
+func (x *StreamResourceGroupsRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+type ResourceGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string            `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name              string            `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Region            string            `protobuf:"bytes,3,opt,name=Region,proto3" json:"Region,omitempty"`
+	ManagedBy         string            `protobuf:"bytes,4,opt,name=ManagedBy,proto3" json:"ManagedBy,omitempty"`
+	ProvisioningState string            `protobuf:"bytes,5,opt,name=ProvisioningState,proto3" json:"ProvisioningState,omitempty"`
+	Tags              map[string]string `protobuf:"bytes,6,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ResourceGroup) Reset() {
+	*x = ResourceGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceGroup) ProtoMessage() {}
+
+func (x *ResourceGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceGroup.ProtoReflect.Descriptor instead.
+func (*ResourceGroup) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResourceGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetManagedBy() string {
+	if x != nil {
+		return x.ManagedBy
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetProvisioningState() string {
+	if x != nil {
+		return x.ProvisioningState
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResourceGroup is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
+	ResourceGroup string `protobuf:"bytes,1,opt,name=ResourceGroup,proto3" json:"ResourceGroup,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in ResourceGroup or the server's
+	// default subscription is used. If ResourceGroup is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// PageSize is the maximum number of resources to return. If 0, a default of 100 is used.
+	// Values above 1000 are set to 1000.
+	PageSize int32 `protobuf:"varint,3,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// PageToken is the NextPageToken from a previous ListResourcesReply. It is used to resume listing.
+	PageToken string `protobuf:"bytes,4,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+}
+
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest) ProtoMessage() {}
+
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListResourcesRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResourcesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListResourcesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*Resource `protobuf:"bytes,1,rep,name=Resources,proto3" json:"Resources,omitempty"`
+	// NextPageToken is set if there are more resources. Pass it as PageToken to get the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *ListResourcesReply) Reset() {
+	*x = ListResourcesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourcesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesReply) ProtoMessage() {}
+
+func (x *ListResourcesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesReply.ProtoReflect.Descriptor instead.
+func (*ListResourcesReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListResourcesReply) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ListResourcesReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Resource is a resource inside a resource group.
+type Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Type is the resource type, such as "Microsoft.Storage/storageAccounts".
+	Type   string `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Region string `protobuf:"bytes,4,opt,name=Region,proto3" json:"Region,omitempty"`
+	// Kind is set by resource providers that have variants of a type, such as "StorageV2".
+	Kind      string            `protobuf:"bytes,5,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Tags      map[string]string `protobuf:"bytes,6,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ManagedBy string            `protobuf:"bytes,7,opt,name=ManagedBy,proto3" json:"ManagedBy,omitempty"`
+	// Properties are the properties of the resource as JSON. They are only set by GetResource and in
+	// the results of CreateOrUpdateResource and UpdateResource.
+	Properties string `protobuf:"bytes,8,opt,name=Properties,proto3" json:"Properties,omitempty"`
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Resource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Resource) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Resource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Resource) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Resource) GetManagedBy() string {
+	if x != nil {
+		return x.ManagedBy
+	}
+	return ""
+}
+
+func (x *Resource) GetProperties() string {
+	if x != nil {
+		return x.Properties
+	}
+	return ""
+}
+
+type GetResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the ARM ID of the resource.
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// ApiVersion is the API version of the resource type to use. If empty, the newest stable version
+	// is found through the providers API.
+	ApiVersion string `protobuf:"bytes,2,opt,name=ApiVersion,proto3" json:"ApiVersion,omitempty"`
+}
+
+func (x *GetResourceRequest) Reset() {
+	*x = GetResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceRequest) ProtoMessage() {}
+
+func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetResourceRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+type GetResourceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resource *Resource `protobuf:"bytes,1,opt,name=Resource,proto3" json:"Resource,omitempty"`
+	// ApiVersion is the API version that was used.
+	ApiVersion string `protobuf:"bytes,2,opt,name=ApiVersion,proto3" json:"ApiVersion,omitempty"`
+}
+
+func (x *GetResourceReply) Reset() {
+	*x = GetResourceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceReply) ProtoMessage() {}
+
+func (x *GetResourceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceReply.ProtoReflect.Descriptor instead.
+func (*GetResourceReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetResourceReply) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *GetResourceReply) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+type CreateOrUpdateResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the ARM ID of the resource.
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// ApiVersion is the API version of the resource type to use. If empty, the newest stable version
+	// is found through the providers API.
+	ApiVersion string            `protobuf:"bytes,2,opt,name=ApiVersion,proto3" json:"ApiVersion,omitempty"`
+	Region     string            `protobuf:"bytes,3,opt,name=Region,proto3" json:"Region,omitempty"`
+	Kind       string            `protobuf:"bytes,4,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	ManagedBy  string            `protobuf:"bytes,5,opt,name=ManagedBy,proto3" json:"ManagedBy,omitempty"`
+	Tags       map[string]string `protobuf:"bytes,6,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Properties are the properties of the resource as JSON.
+	Properties string `protobuf:"bytes,7,opt,name=Properties,proto3" json:"Properties,omitempty"`
+}
+
+func (x *CreateOrUpdateResourceRequest) Reset() {
+	*x = CreateOrUpdateResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrUpdateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateResourceRequest) ProtoMessage() {}
+
+func (x *CreateOrUpdateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrUpdateResourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateOrUpdateResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateResourceRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateResourceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateResourceRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateResourceRequest) GetManagedBy() string {
+	if x != nil {
+		return x.ManagedBy
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateResourceRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateOrUpdateResourceRequest) GetProperties() string {
+	if x != nil {
+		return x.Properties
+	}
+	return ""
+}
+
+type CreateOrUpdateResourceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation creating the resource.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *CreateOrUpdateResourceReply) Reset() {
+	*x = CreateOrUpdateResourceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrUpdateResourceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdateResourceReply) ProtoMessage() {}
+
+func (x *CreateOrUpdateResourceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrUpdateResourceReply.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdateResourceReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateOrUpdateResourceReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateOrUpdateResourceReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type UpdateResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the ARM ID of the resource.
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// ApiVersion is the API version of the resource type to use. If empty, the newest stable version
+	// is found through the providers API.
+	ApiVersion string `protobuf:"bytes,2,opt,name=ApiVersion,proto3" json:"ApiVersion,omitempty"`
+	// Tags replace the tags of the resource.
+	Tags map[string]string `protobuf:"bytes,3,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Properties are the properties to patch as JSON.
+	Properties string `protobuf:"bytes,4,opt,name=Properties,proto3" json:"Properties,omitempty"`
+	// UpdateMask names the fields of this message that are patched. Only "Tags" and "Properties"
+	// may be updated and at least one must be given.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+}
+
+func (x *UpdateResourceRequest) Reset() {
+	*x = UpdateResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourceRequest) ProtoMessage() {}
+
+func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateResourceRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *UpdateResourceRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateResourceRequest) GetProperties() string {
+	if x != nil {
+		return x.Properties
+	}
+	return ""
+}
+
+func (x *UpdateResourceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateResourceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation updating the resource.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *UpdateResourceReply) Reset() {
+	*x = UpdateResourceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResourceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourceReply) ProtoMessage() {}
+
+func (x *UpdateResourceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourceReply.ProtoReflect.Descriptor instead.
+func (*UpdateResourceReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateResourceReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateResourceReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type DeleteResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the ARM ID of the resource.
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// ApiVersion is the API version of the resource type to use. If empty, the newest stable version
+	// is found through the providers API.
+	ApiVersion string `protobuf:"bytes,2,opt,name=ApiVersion,proto3" json:"ApiVersion,omitempty"`
+}
+
+func (x *DeleteResourceRequest) Reset() {
+	*x = DeleteResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteResourceRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+type DeleteResourceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation deleting the resource.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *DeleteResourceReply) Reset() {
+	*x = DeleteResourceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResourceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceReply) ProtoMessage() {}
+
+func (x *DeleteResourceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceReply.ProtoReflect.Descriptor instead.
+func (*DeleteResourceReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteResourceReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteResourceReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type MoveResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SourceResourceGroup is the name of the resource group the resources are in or its ARM ID
+	// ("/subscriptions/<subscription>/resourceGroups/<name>").
+	SourceResourceGroup string `protobuf:"bytes,1,opt,name=SourceResourceGroup,proto3" json:"SourceResourceGroup,omitempty"`
+	// Subscription is the subscription of SourceResourceGroup. If empty, the subscription in SourceResourceGroup
+	// or the server's default subscription is used. If SourceResourceGroup is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// ResourceIds are the ARM IDs of the resources to move. They must all be in SourceResourceGroup.
+	ResourceIds []string `protobuf:"bytes,3,rep,name=ResourceIds,proto3" json:"ResourceIds,omitempty"`
+	// TargetResourceGroup is the name of the resource group to move the resources to, which must be in the
+	// subscription of SourceResourceGroup, or its ARM ID to move the resources to another subscription.
+	TargetResourceGroup string `protobuf:"bytes,4,opt,name=TargetResourceGroup,proto3" json:"TargetResourceGroup,omitempty"`
+}
+
+func (x *MoveResourcesRequest) Reset() {
+	*x = MoveResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveResourcesRequest) ProtoMessage() {}
+
+func (x *MoveResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveResourcesRequest.ProtoReflect.Descriptor instead.
+func (*MoveResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MoveResourcesRequest) GetSourceResourceGroup() string {
+	if x != nil {
+		return x.SourceResourceGroup
+	}
+	return ""
+}
+
+func (x *MoveResourcesRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *MoveResourcesRequest) GetResourceIds() []string {
+	if x != nil {
+		return x.ResourceIds
+	}
+	return nil
+}
+
+func (x *MoveResourcesRequest) GetTargetResourceGroup() string {
+	if x != nil {
+		return x.TargetResourceGroup
+	}
+	return ""
+}
+
+type ValidateMoveResourcesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation validating the move.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *ValidateMoveResourcesReply) Reset() {
+	*x = ValidateMoveResourcesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateMoveResourcesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateMoveResourcesReply) ProtoMessage() {}
+
+func (x *ValidateMoveResourcesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateMoveResourcesReply.ProtoReflect.Descriptor instead.
+func (*ValidateMoveResourcesReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ValidateMoveResourcesReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ValidateMoveResourcesReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type MoveResourcesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation moving the resources.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *MoveResourcesReply) Reset() {
+	*x = MoveResourcesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveResourcesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveResourcesReply) ProtoMessage() {}
+
+func (x *MoveResourcesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveResourcesReply.ProtoReflect.Descriptor instead.
+func (*MoveResourcesReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MoveResourcesReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MoveResourcesReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type DeployTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResourceGroup is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
+	ResourceGroup string `protobuf:"bytes,1,opt,name=ResourceGroup,proto3" json:"ResourceGroup,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in ResourceGroup or the server's
+	// default subscription is used. If ResourceGroup is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// Name is the name of the deployment. Deploying with the name of an existing deployment replaces it.
+	Name string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Template is the ARM template as JSON. Exactly one of Template and TemplateUri must be set.
+	Template string `protobuf:"bytes,4,opt,name=Template,proto3" json:"Template,omitempty"`
+	// TemplateUri is the URI of the ARM template, which ARM must be able to read.
+	TemplateUri string `protobuf:"bytes,5,opt,name=TemplateUri,proto3" json:"TemplateUri,omitempty"`
+	// Parameters are the template parameters as JSON. Either a map of parameter names to {"value": ...}
+	// or a parameters file, which has a "$schema" key and the map in its "parameters" key.
+	Parameters string         `protobuf:"bytes,6,opt,name=Parameters,proto3" json:"Parameters,omitempty"`
+	Mode       DeploymentMode `protobuf:"varint,7,opt,name=Mode,proto3,enum=service.DeploymentMode" json:"Mode,omitempty"`
+}
+
+func (x *DeployTemplateRequest) Reset() {
+	*x = DeployTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployTemplateRequest) ProtoMessage() {}
+
+func (x *DeployTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeployTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeployTemplateRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+func (x *DeployTemplateRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *DeployTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeployTemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *DeployTemplateRequest) GetTemplateUri() string {
+	if x != nil {
+		return x.TemplateUri
+	}
+	return ""
+}
+
+func (x *DeployTemplateRequest) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+func (x *DeployTemplateRequest) GetMode() DeploymentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DeploymentMode_DEPLOYMENT_MODE_UNSPECIFIED
+}
+
+type DeployTemplateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation deploying the template.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *DeployTemplateReply) Reset() {
+	*x = DeployTemplateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployTemplateReply) ProtoMessage() {}
+
+func (x *DeployTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployTemplateReply.ProtoReflect.Descriptor instead.
+func (*DeployTemplateReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeployTemplateReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeployTemplateReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type ValidateDeploymentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation validating the deployment.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *ValidateDeploymentReply) Reset() {
+	*x = ValidateDeploymentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateDeploymentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDeploymentReply) ProtoMessage() {}
+
+func (x *ValidateDeploymentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDeploymentReply.ProtoReflect.Descriptor instead.
+func (*ValidateDeploymentReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ValidateDeploymentReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ValidateDeploymentReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type WhatIfDeploymentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	// OperationId is the ID of the long-running operation predicting the changes.
+	OperationId string `protobuf:"bytes,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *WhatIfDeploymentReply) Reset() {
+	*x = WhatIfDeploymentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhatIfDeploymentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhatIfDeploymentReply) ProtoMessage() {}
+
+func (x *WhatIfDeploymentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhatIfDeploymentReply.ProtoReflect.Descriptor instead.
+func (*WhatIfDeploymentReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WhatIfDeploymentReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WhatIfDeploymentReply) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+// ARMError is an error reported by ARM in the result of a deployment, validation or what-if.
+type ARMError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Target is usually the ID of the resource the error is about.
+	Target string `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+}
+
+func (x *ARMError) Reset() {
+	*x = ARMError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ARMError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ARMError) ProtoMessage() {}
+
+func (x *ARMError) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ARMError.ProtoReflect.Descriptor instead.
+func (*ARMError) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ARMError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ARMError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ARMError) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// Deployment is a template deployment to a resource group.
+type Deployment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	// ProvisioningState is ARM's state of the deployment, such as "Running", "Succeeded" or "Failed".
+	ProvisioningState string         `protobuf:"bytes,3,opt,name=ProvisioningState,proto3" json:"ProvisioningState,omitempty"`
+	Mode              DeploymentMode `protobuf:"varint,4,opt,name=Mode,proto3,enum=service.DeploymentMode" json:"Mode,omitempty"`
+	// Timestamp is when the deployment was last updated.
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,6,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	CorrelationId string                 `protobuf:"bytes,7,opt,name=CorrelationId,proto3" json:"CorrelationId,omitempty"`
+	// Outputs are the outputs of the template as JSON.
+	Outputs string `protobuf:"bytes,8,opt,name=Outputs,proto3" json:"Outputs,omitempty"`
+	// OutputResources are the IDs of the resources the deployment provisioned.
+	OutputResources []string `protobuf:"bytes,9,rep,name=OutputResources,proto3" json:"OutputResources,omitempty"`
+	// Errors are the errors reported for a failed deployment.
+	Errors []*ARMError `protobuf:"bytes,10,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *Deployment) Reset() {
+	*x = Deployment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deployment) ProtoMessage() {}
+
+func (x *Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Deployment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Deployment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Deployment) GetProvisioningState() string {
+	if x != nil {
+		return x.ProvisioningState
+	}
+	return ""
+}
+
+func (x *Deployment) GetMode() DeploymentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DeploymentMode_DEPLOYMENT_MODE_UNSPECIFIED
+}
+
+func (x *Deployment) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Deployment) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Deployment) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *Deployment) GetOutputs() string {
+	if x != nil {
+		return x.Outputs
+	}
+	return ""
+}
+
+func (x *Deployment) GetOutputResources() []string {
+	if x != nil {
+		return x.OutputResources
+	}
+	return nil
+}
+
+func (x *Deployment) GetErrors() []*ARMError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ValidateDeploymentResult is the result of a ValidateDeployment operation.
+type ValidateDeploymentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Valid is true if ARM accepted the deployment.
+	Valid bool `protobuf:"varint,1,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	// Errors are the reasons ARM rejected the deployment.
+	Errors []*ARMError `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	// ValidatedResources are the IDs of the resources the deployment would provision.
+	ValidatedResources []string `protobuf:"bytes,3,rep,name=ValidatedResources,proto3" json:"ValidatedResources,omitempty"`
+}
+
+func (x *ValidateDeploymentResult) Reset() {
+	*x = ValidateDeploymentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateDeploymentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDeploymentResult) ProtoMessage() {}
+
+func (x *ValidateDeploymentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDeploymentResult.ProtoReflect.Descriptor instead.
+func (*ValidateDeploymentResult) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ValidateDeploymentResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateDeploymentResult) GetErrors() []*ARMError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateDeploymentResult) GetValidatedResources() []string {
+	if x != nil {
+		return x.ValidatedResources
+	}
+	return nil
+}
+
+// WhatIfResult is the result of a WhatIfDeployment operation.
+type WhatIfResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string          `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Errors  []*ARMError     `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	Changes []*WhatIfChange `protobuf:"bytes,3,rep,name=Changes,proto3" json:"Changes,omitempty"`
+}
+
+func (x *WhatIfResult) Reset() {
+	*x = WhatIfResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhatIfResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhatIfResult) ProtoMessage() {}
+
+func (x *WhatIfResult) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhatIfResult.ProtoReflect.Descriptor instead.
+func (*WhatIfResult) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WhatIfResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WhatIfResult) GetErrors() []*ARMError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *WhatIfResult) GetChanges() []*WhatIfChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// WhatIfChange is the predicted change to one resource.
+type WhatIfChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId string `protobuf:"bytes,1,opt,name=ResourceId,proto3" json:"ResourceId,omitempty"`
+	// ChangeType is ARM's type of change, such as "Create", "Delete", "Modify" or "NoChange".
+	ChangeType string `protobuf:"bytes,2,opt,name=ChangeType,proto3" json:"ChangeType,omitempty"`
+	// UnsupportedReason explains why What-If could not predict the change when ChangeType is "Unsupported".
+	UnsupportedReason string `protobuf:"bytes,3,opt,name=UnsupportedReason,proto3" json:"UnsupportedReason,omitempty"`
+	// Before and After are the resource before and after the deployment as JSON.
+	Before string `protobuf:"bytes,4,opt,name=Before,proto3" json:"Before,omitempty"`
+	After  string `protobuf:"bytes,5,opt,name=After,proto3" json:"After,omitempty"`
+	// Delta are the changed properties. Nested changes are flattened, with the full path of each property.
+	Delta []*PropertyChange `protobuf:"bytes,6,rep,name=Delta,proto3" json:"Delta,omitempty"`
+}
+
+func (x *WhatIfChange) Reset() {
+	*x = WhatIfChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhatIfChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhatIfChange) ProtoMessage() {}
+
+func (x *WhatIfChange) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhatIfChange.ProtoReflect.Descriptor instead.
+func (*WhatIfChange) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WhatIfChange) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *WhatIfChange) GetChangeType() string {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ""
+}
+
+func (x *WhatIfChange) GetUnsupportedReason() string {
+	if x != nil {
+		return x.UnsupportedReason
+	}
+	return ""
+}
+
+func (x *WhatIfChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *WhatIfChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *WhatIfChange) GetDelta() []*PropertyChange {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+// PropertyChange is the predicted change to one property of a resource.
+type PropertyChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path is the path of the property, such as "properties.addressSpace.addressPrefixes[0]".
+	Path       string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	ChangeType string `protobuf:"bytes,2,opt,name=ChangeType,proto3" json:"ChangeType,omitempty"`
+	// Before and After are the values of the property before and after the deployment as JSON.
+	Before string `protobuf:"bytes,3,opt,name=Before,proto3" json:"Before,omitempty"`
+	After  string `protobuf:"bytes,4,opt,name=After,proto3" json:"After,omitempty"`
+}
+
+func (x *PropertyChange) Reset() {
+	*x = PropertyChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PropertyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyChange) ProtoMessage() {}
+
+func (x *PropertyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyChange.ProtoReflect.Descriptor instead.
+func (*PropertyChange) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PropertyChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PropertyChange) GetChangeType() string {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ""
+}
+
+func (x *PropertyChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *PropertyChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type GetDeploymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResourceGroup is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
+	ResourceGroup string `protobuf:"bytes,1,opt,name=ResourceGroup,proto3" json:"ResourceGroup,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in ResourceGroup or the server's
+	// default subscription is used. If ResourceGroup is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *GetDeploymentRequest) Reset() {
+	*x = GetDeploymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentRequest) ProtoMessage() {}
+
+func (x *GetDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetDeploymentRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+func (x *GetDeploymentRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *GetDeploymentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListDeploymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResourceGroup is the name of the resource group or its ARM ID ("/subscriptions/<subscription>/resourceGroups/<name>").
+	ResourceGroup string `protobuf:"bytes,1,opt,name=ResourceGroup,proto3" json:"ResourceGroup,omitempty"`
+	// Subscription is the subscription to act on. If empty, the subscription in ResourceGroup or the server's
+	// default subscription is used. If ResourceGroup is an ARM ID, they must match.
+	Subscription string `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// PageSize is the maximum number of deployments to return. If 0, a default of 100 is used.
+	// Values above 1000 are set to 1000.
+	PageSize int32 `protobuf:"varint,3,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// PageToken is the NextPageToken from a previous ListDeploymentsReply. It is used to resume listing.
+	PageToken string `protobuf:"bytes,4,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+}
+
+func (x *ListDeploymentsRequest) Reset() {
+	*x = ListDeploymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsRequest) ProtoMessage() {}
+
+func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListDeploymentsRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeploymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDeploymentsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deployments []*Deployment `protobuf:"bytes,1,rep,name=Deployments,proto3" json:"Deployments,omitempty"`
+	// NextPageToken is set if there are more deployments. Pass it as PageToken to get the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *ListDeploymentsReply) Reset() {
+	*x = ListDeploymentsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsReply) ProtoMessage() {}
+
+func (x *ListDeploymentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsReply.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListDeploymentsReply) GetDeployments() []*Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+func (x *ListDeploymentsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetTagsAtScopeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scope is the ARM ID of a subscription, resource group or resource.
+	Scope string `protobuf:"bytes,1,opt,name=Scope,proto3" json:"Scope,omitempty"`
+}
+
+func (x *GetTagsAtScopeRequest) Reset() {
+	*x = GetTagsAtScopeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagsAtScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagsAtScopeRequest) ProtoMessage() {}
+
+func (x *GetTagsAtScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagsAtScopeRequest.ProtoReflect.Descriptor instead.
+func (*GetTagsAtScopeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetTagsAtScopeRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type PatchTagsAtScopeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scope is the ARM ID of a subscription, resource group or resource.
+	Scope string `protobuf:"bytes,1,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	// TagOperation is how Tags are applied to the existing tags on Scope. It is required.
+	TagOperation TagOperation `protobuf:"varint,2,opt,name=TagOperation,proto3,enum=service.TagOperation" json:"TagOperation,omitempty"`
+	// Tags are the tags to merge, replace or delete. They are required unless TagOperation is TAG_OPERATION_REPLACE.
+	Tags map[string]string `protobuf:"bytes,3,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PatchTagsAtScopeRequest) Reset() {
+	*x = PatchTagsAtScopeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchTagsAtScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchTagsAtScopeRequest) ProtoMessage() {}
+
+func (x *PatchTagsAtScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchTagsAtScopeRequest.ProtoReflect.Descriptor instead.
+func (*PatchTagsAtScopeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PatchTagsAtScopeRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *PatchTagsAtScopeRequest) GetTagOperation() TagOperation {
+	if x != nil {
+		return x.TagOperation
+	}
+	return TagOperation_TAG_OPERATION_UNSPECIFIED
+}
+
+func (x *PatchTagsAtScopeRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// TagsAtScope are the tags on a scope.
+type TagsAtScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope string            `protobuf:"bytes,1,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	Tags  map[string]string `protobuf:"bytes,2,rep,name=Tags,proto3" json:"Tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TagsAtScope) Reset() {
+	*x = TagsAtScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagsAtScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsAtScope) ProtoMessage() {}
+
+func (x *TagsAtScope) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsAtScope.ProtoReflect.Descriptor instead.
+func (*TagsAtScope) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TagsAtScope) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *TagsAtScope) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListTagNamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscription is the subscription to list. If empty, the server's default subscription is used.
+	Subscription string `protobuf:"bytes,1,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// PageSize is the maximum number of tag names to return. If 0, a default of 100 is used.
+	// Values above 1000 are set to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// PageToken is the NextPageToken from a previous ListTagNamesReply. It is used to resume listing.
+	PageToken string `protobuf:"bytes,3,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+}
+
+func (x *ListTagNamesRequest) Reset() {
+	*x = ListTagNamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagNamesRequest) ProtoMessage() {}
+
+func (x *ListTagNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagNamesRequest.ProtoReflect.Descriptor instead.
+func (*ListTagNamesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListTagNamesRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ListTagNamesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTagNamesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTagNamesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagNames []*TagName `protobuf:"bytes,1,rep,name=TagNames,proto3" json:"TagNames,omitempty"`
+	// NextPageToken is set if there are more tag names. Pass it as PageToken to get the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *ListTagNamesReply) Reset() {
+	*x = ListTagNamesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagNamesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagNamesReply) ProtoMessage() {}
+
+func (x *ListTagNamesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagNamesReply.ProtoReflect.Descriptor instead.
+func (*ListTagNamesReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListTagNamesReply) GetTagNames() []*TagName {
+	if x != nil {
+		return x.TagNames
+	}
+	return nil
+}
+
+func (x *ListTagNamesReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// TagName is a tag name used in a subscription.
+type TagName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Count is the number of resources using the tag name.
+	Count  int32            `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Values []*TagValueCount `protobuf:"bytes,3,rep,name=Values,proto3" json:"Values,omitempty"`
+}
+
+func (x *TagName) Reset() {
+	*x = TagName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagName) ProtoMessage() {}
+
+func (x *TagName) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagName.ProtoReflect.Descriptor instead.
+func (*TagName) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TagName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagName) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TagName) GetValues() []*TagValueCount {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// TagValueCount is a value of a tag name and the number of resources using it.
+type TagValueCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *TagValueCount) Reset() {
+	*x = TagValueCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagValueCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagValueCount) ProtoMessage() {}
+
+func (x *TagValueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagValueCount.ProtoReflect.Descriptor instead.
+func (*TagValueCount) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TagValueCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TagValueCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscription is the subscription to list. If empty, the server's default subscription is used.
+	Subscription string `protobuf:"bytes,1,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// PageSize is the maximum number of providers to return. If 0, a default of 100 is used.
+	// Values above 1000 are set to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// PageToken is the NextPageToken from a previous ListProvidersReply. It is used to resume listing.
+	PageToken string `protobuf:"bytes,3,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListProvidersRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ListProvidersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProvidersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListProvidersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []*Provider `protobuf:"bytes,1,rep,name=Providers,proto3" json:"Providers,omitempty"`
+	// NextPageToken is set if there are more providers. Pass it as PageToken to get the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *ListProvidersReply) Reset() {
+	*x = ListProvidersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersReply) ProtoMessage() {}
+
+func (x *ListProvidersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersReply.ProtoReflect.Descriptor instead.
+func (*ListProvidersReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListProvidersReply) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *ListProvidersReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ProviderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscription is the subscription to act on. If empty, the server's default subscription is used.
+	Subscription string `protobuf:"bytes,1,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// Namespace is the namespace of the resource provider, such as "Microsoft.Network".
+	Namespace string `protobuf:"bytes,2,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+}
+
+func (x *ProviderRequest) Reset() {
+	*x = ProviderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRequest) ProtoMessage() {}
+
+func (x *ProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRequest.ProtoReflect.Descriptor instead.
+func (*ProviderRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ProviderRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *ProviderRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// Provider is a resource provider.
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	// RegistrationState is ARM's registration state, such as "Registered", "Registering" or "NotRegistered".
+	RegistrationState string `protobuf:"bytes,3,opt,name=RegistrationState,proto3" json:"RegistrationState,omitempty"`
+	// RegistrationPolicy is ARM's registration policy, such as "RegistrationRequired".
+	RegistrationPolicy string                  `protobuf:"bytes,4,opt,name=RegistrationPolicy,proto3" json:"RegistrationPolicy,omitempty"`
+	ResourceTypes      []*ProviderResourceType `protobuf:"bytes,5,rep,name=ResourceTypes,proto3" json:"ResourceTypes,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Provider) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Provider) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Provider) GetRegistrationState() string {
+	if x != nil {
+		return x.RegistrationState
+	}
+	return ""
+}
+
+func (x *Provider) GetRegistrationPolicy() string {
+	if x != nil {
+		return x.RegistrationPolicy
+	}
+	return ""
+}
+
+func (x *Provider) GetResourceTypes() []*ProviderResourceType {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+// ProviderResourceType is a resource type offered by a resource provider.
+type ProviderResourceType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type is the resource type without the namespace, such as "virtualNetworks".
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// Regions are the regions the type can be created in.
+	Regions []string `protobuf:"bytes,2,rep,name=Regions,proto3" json:"Regions,omitempty"`
+	// ApiVersions are the API versions of the type, usually newest first.
+	ApiVersions []string `protobuf:"bytes,3,rep,name=ApiVersions,proto3" json:"ApiVersions,omitempty"`
+	// DefaultApiVersion is the API version ARM uses by default, if it has one.
+	DefaultApiVersion string `protobuf:"bytes,4,opt,name=DefaultApiVersion,proto3" json:"DefaultApiVersion,omitempty"`
+}
+
+func (x *ProviderResourceType) Reset() {
+	*x = ProviderResourceType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderResourceType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderResourceType) ProtoMessage() {}
+
+func (x *ProviderResourceType) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderResourceType.ProtoReflect.Descriptor instead.
+func (*ProviderResourceType) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ProviderResourceType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProviderResourceType) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *ProviderResourceType) GetApiVersions() []string {
+	if x != nil {
+		return x.ApiVersions
+	}
+	return nil
+}
+
+func (x *ProviderResourceType) GetDefaultApiVersion() string {
+	if x != nil {
+		return x.DefaultApiVersion
+	}
+	return ""
+}
+
+// Operation is a long-running operation being tracked by the server.
+type Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Kind is the RPC that started the operation, such as "DeleteResourceGroup".
+	Kind string `protobuf:"bytes,2,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	// Target is the resource the operation acts on.
+	Target     string                 `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+	State      OperationState         `protobuf:"varint,4,opt,name=State,proto3,enum=service.OperationState" json:"State,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreateTime,proto3" json:"CreateTime,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=UpdateTime,proto3" json:"UpdateTime,omitempty"`
+	// Polls is the number of times ARM has been polled for the status of the operation.
+	Polls int32 `protobuf:"varint,7,opt,name=Polls,proto3" json:"Polls,omitempty"`
+	// LastHTTPStatus is the HTTP status of the last poll.
+	LastHTTPStatus int32 `protobuf:"varint,8,opt,name=LastHTTPStatus,proto3" json:"LastHTTPStatus,omitempty"`
+	// ErrorCode and ErrorMessage are the gRPC code and message if State is FAILED.
+	ErrorCode    int32  `protobuf:"varint,9,opt,name=ErrorCode,proto3" json:"ErrorCode,omitempty"`
+	ErrorMessage string `protobuf:"bytes,10,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	// Result is the result of the operation, if it has one, when State is SUCCEEDED.
+	Result *anypb.Any `protobuf:"bytes,11,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Operation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Operation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Operation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Operation) GetState() OperationState {
+	if x != nil {
+		return x.State
+	}
+	return OperationState_OPERATION_STATE_UNSPECIFIED
+}
+
+func (x *Operation) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Operation) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Operation) GetPolls() int32 {
+	if x != nil {
+		return x.Polls
+	}
+	return 0
+}
+
+func (x *Operation) GetLastHTTPStatus() int32 {
+	if x != nil {
+		return x.LastHTTPStatus
+	}
+	return 0
+}
+
+func (x *Operation) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *Operation) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *Operation) GetResult() *anypb.Any {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WaitOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Timeout is the maximum time to wait. If not set, waits until the operation is done or the RPC's deadline.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
+}
+
+func (x *WaitOperationRequest) Reset() {
+	*x = WaitOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitOperationRequest) ProtoMessage() {}
+
+func (x *WaitOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitOperationRequest.ProtoReflect.Descriptor instead.
+func (*WaitOperationRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WaitOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitOperationRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type ListOperationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kind, if set, only lists operations of this kind.
+	Kind string `protobuf:"bytes,1,opt,name=Kind,proto3" json:"Kind,omitempty"`
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListOperationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type ListOperationsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*Operation `protobuf:"bytes,1,rep,name=Operations,proto3" json:"Operations,omitempty"`
+}
+
+func (x *ListOperationsReply) Reset() {
+	*x = ListOperationsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsReply) ProtoMessage() {}
+
+func (x *ListOperationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsReply.ProtoReflect.Descriptor instead.
+func (*ListOperationsReply) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListOperationsReply) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type CancelOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+}
+
+func (x *CancelOperationRequest) Reset() {
+	*x = CancelOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOperationRequest) ProtoMessage() {}
+
+func (x *CancelOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CancelOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto protoreflect.FileDescriptor
+
+var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDesc = []byte{
+	0x0a, 0x59, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x6f, 0x66, 0x2d, 0x73, 0x75, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x77, 0x69, 0x74, 0x68, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x1a, 0x60, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x6f, 0x66, 0x2d, 0x73, 0x75, 0x72, 0x70,
+	0x72, 0x69, 0x73, 0x65, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x77, 0x69, 0x74, 0x68, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x65, 0x65,
+	0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x26, 0x0a, 0x0a, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x65,
+	0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x7a,
+	0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x7a, 0x69,
+	0x70, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x32, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x70, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x42, 0x79, 0x70, 0x61, 0x73,
+	0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0x6e, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x50, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x91, 0x03, 0x0a,
+	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x04, 0x54,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x39,
+	0x0a, 0x0c, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
+	0x61, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x54, 0x61, 0x67,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x70, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x50, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc8, 0x01, 0x0a, 0x22, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x1d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x49,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x20, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x53, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x6c,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x48, 0x54, 0x54, 0x50, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a,
+	0x07, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x45, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x22, 0x7f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54,
+	0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54,
+	0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x02, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x54, 0x61, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x96,
+	0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4b,
+	0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x2f, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x37,
+	0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x2d, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xb8, 0x02, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x44, 0x0a, 0x04,
+	0x54, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9a, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c,
+	0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x4f, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a,
+	0x14, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a,
+	0x13, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x56, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x72, 0x69, 0x12, 0x1e, 0x0a,
+	0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4f, 0x0a, 0x13, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x51, 0x0a, 0x15, 0x57, 0x68, 0x61, 0x74, 0x49, 0x66, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x08, 0x41, 0x52, 0x4d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x91, 0x03, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a,
+	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x52, 0x4d, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x52, 0x4d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x57, 0x68, 0x61, 0x74,
+	0x49, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x29, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x52, 0x4d, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x68, 0x61, 0x74, 0x49, 0x66, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xd9, 0x01, 0x0a,
+	0x0c, 0x57, 0x68, 0x61, 0x74, 0x49, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x72, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x74, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x73, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x0b, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x17, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x61, 0x67, 0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x54, 0x61, 0x67, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x61, 0x67, 0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x0b,
+	0x54, 0x61, 0x67, 0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x32, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x41, 0x74,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x67, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x08, 0x54, 0x61,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x63, 0x0a, 0x07,
+	0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x3b, 0x0a, 0x0d, 0x54, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x74,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x53, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x2e, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x43, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x41,
+	0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x03, 0x0a, 0x09,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x50, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x50, 0x6f, 0x6c,
+	0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x4c, 0x61, 0x73, 0x74,
+	0x48, 0x54, 0x54, 0x50, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x64, 0x22, 0x5b, 0x0a, 0x14, 0x57, 0x61, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x2b,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x49, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
+	0x2a, 0x7b, 0x0a, 0x0c, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x47, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43,
+	0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x41, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x70, 0x0a,
+	0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x2a,
+	0xa8, 0x01, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xc8, 0x15, 0x0a, 0x03, 0x52,
+	0x50, 0x43, 0x12, 0x38, 0x0a, 0x08, 0x53, 0x61, 0x79, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x15,
+	0x2e, 0x67, 0x72, 0x65, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67, 0x72, 0x65, 0x65, 0x74, 0x65, 0x72, 0x2e,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a,
+	0x11, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1b, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x14, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x15, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x4d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x12, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x57, 0x68, 0x61, 0x74, 0x49, 0x66, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x57, 0x68, 0x61, 0x74, 0x49, 0x66, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x00, 0x12, 0x53, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x41, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x61, 0x67, 0x73, 0x41, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x00, 0x12, 0x4a,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12, 0x55, 0x6e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57,
+	0x61, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x6f, 0x66, 0x2d, 0x73,
+	0x75, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x77, 0x69, 0x74,
+	0x68, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescOnce sync.Once
+	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData = file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDesc
+)
+
+func file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescGZIP() []byte {
+	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescOnce.Do(func() {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData)
+	})
+	return file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDescData
+}
+
+var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_goTypes = []interface{}{
+	(TagOperation)(0),                          // 0: service.TagOperation
+	(DeploymentMode)(0),                        // 1: service.DeploymentMode
+	(OperationState)(0),                        // 2: service.OperationState
+	(*HelloRequest)(nil),                       // 3: service.HelloRequest
+	(*HelloReply)(nil),                         // 4: service.HelloReply
+	(*Address)(nil),                            // 5: service.Address
+	(*CreateResourceGroupRequest)(nil),         // 6: service.CreateResourceGroupRequest
+	(*CreateResourceGroupReply)(nil),           // 7: service.CreateResourceGroupReply
+	(*ReadResourceGroupRequest)(nil),           // 8: service.ReadResourceGroupRequest
+	(*ReadResourceGroupReply)(nil),             // 9: service.ReadResourceGroupReply
+	(*ResourceGroupExistsRequest)(nil),         // 10: service.ResourceGroupExistsRequest
+	(*ResourceGroupExistsReply)(nil),           // 11: service.ResourceGroupExistsReply
+	(*UpdateResourceGroupRequest)(nil),         // 12: service.UpdateResourceGroupRequest
+	(*UpdateResourceGroupReply)(nil),           // 13: service.UpdateResourceGroupReply
+	(*DeleteResourceGroupRequest)(nil),         // 14: service.DeleteResourceGroupRequest
+	(*DeleteResourceGroupReply)(nil),           // 15: service.DeleteResourceGroupReply
+	(*ExportResourceGroupTemplateRequest)(nil), // 16: service.ExportResourceGroupTemplateRequest
+	(*ExportResourceGroupTemplateReply)(nil),   // 17: service.ExportResourceGroupTemplateReply
+	(*ExportError)(nil),                        // 18: service.ExportError
+	(*DeleteResourceGroupEvent)(nil),           // 19: service.DeleteResourceGroupEvent
+	(*ListResourceGroupsRequest)(nil),          // 20: service.ListResourceGroupsRequest
+	(*ListResourceGroupsReply)(nil),            // 21: service.ListResourceGroupsReply
+	(*StreamResourceGroupsRequest)(nil),        // 22: service.StreamResourceGroupsRequest
+	(*ResourceGroup)(nil),                      // 23: service.ResourceGroup
+	(*ListResourcesRequest)(nil),               // 24: service.ListResourcesRequest
+	(*ListResourcesReply)(nil),                 // 25: service.ListResourcesReply
+	(*Resource)(nil),                           // 26: service.Resource
+	(*GetResourceRequest)(nil),                 // 27: service.GetResourceRequest
+	(*GetResourceReply)(nil),                   // 28: service.GetResourceReply
+	(*CreateOrUpdateResourceRequest)(nil),      // 29: service.CreateOrUpdateResourceRequest
+	(*CreateOrUpdateResourceReply)(nil),        // 30: service.CreateOrUpdateResourceReply
+	(*UpdateResourceRequest)(nil),              // 31: service.UpdateResourceRequest
+	(*UpdateResourceReply)(nil),                // 32: service.UpdateResourceReply
+	(*DeleteResourceRequest)(nil),              // 33: service.DeleteResourceRequest
+	(*DeleteResourceReply)(nil),                // 34: service.DeleteResourceReply
+	(*MoveResourcesRequest)(nil),               // 35: service.MoveResourcesRequest
+	(*ValidateMoveResourcesReply)(nil),         // 36: service.ValidateMoveResourcesReply
+	(*MoveResourcesReply)(nil),                 // 37: service.MoveResourcesReply
+	(*DeployTemplateRequest)(nil),              // 38: service.DeployTemplateRequest
+	(*DeployTemplateReply)(nil),                // 39: service.DeployTemplateReply
+	(*ValidateDeploymentReply)(nil),            // 40: service.ValidateDeploymentReply
+	(*WhatIfDeploymentReply)(nil),              // 41: service.WhatIfDeploymentReply
+	(*ARMError)(nil),                           // 42: service.ARMError
+	(*Deployment)(nil),                         // 43: service.Deployment
+	(*ValidateDeploymentResult)(nil),           // 44: service.ValidateDeploymentResult
+	(*WhatIfResult)(nil),                       // 45: service.WhatIfResult
+	(*WhatIfChange)(nil),                       // 46: service.WhatIfChange
+	(*PropertyChange)(nil),                     // 47: service.PropertyChange
+	(*GetDeploymentRequest)(nil),               // 48: service.GetDeploymentRequest
+	(*ListDeploymentsRequest)(nil),             // 49: service.ListDeploymentsRequest
+	(*ListDeploymentsReply)(nil),               // 50: service.ListDeploymentsReply
+	(*GetTagsAtScopeRequest)(nil),              // 51: service.GetTagsAtScopeRequest
+	(*PatchTagsAtScopeRequest)(nil),            // 52: service.PatchTagsAtScopeRequest
+	(*TagsAtScope)(nil),                        // 53: service.TagsAtScope
+	(*ListTagNamesRequest)(nil),                // 54: service.ListTagNamesRequest
+	(*ListTagNamesReply)(nil),                  // 55: service.ListTagNamesReply
+	(*TagName)(nil),                            // 56: service.TagName
+	(*TagValueCount)(nil),                      // 57: service.TagValueCount
+	(*ListProvidersRequest)(nil),               // 58: service.ListProvidersRequest
+	(*ListProvidersReply)(nil),                 // 59: service.ListProvidersReply
+	(*ProviderRequest)(nil),                    // 60: service.ProviderRequest
+	(*Provider)(nil),                           // 61: service.Provider
+	(*ProviderResourceType)(nil),               // 62: service.ProviderResourceType
+	(*Operation)(nil),                          // 63: service.Operation
+	(*GetOperationRequest)(nil),                // 64: service.GetOperationRequest
+	(*WaitOperationRequest)(nil),               // 65: service.WaitOperationRequest
+	(*ListOperationsRequest)(nil),              // 66: service.ListOperationsRequest
+	(*ListOperationsReply)(nil),                // 67: service.ListOperationsReply
+	(*CancelOperationRequest)(nil),             // 68: service.CancelOperationRequest
+	nil,                                        // 69: service.CreateResourceGroupRequest.TagsEntry
+	nil,                                        // 70: service.UpdateResourceGroupRequest.TagsEntry
+	nil,                                        // 71: service.ResourceGroup.TagsEntry
+	nil,                                        // 72: service.Resource.TagsEntry
+	nil,                                        // 73: service.CreateOrUpdateResourceRequest.TagsEntry
+	nil,                                        // 74: service.UpdateResourceRequest.TagsEntry
+	nil,                                        // 75: service.PatchTagsAtScopeRequest.TagsEntry
+	nil,                                        // 76: service.TagsAtScope.TagsEntry
+	(*fieldmaskpb.FieldMask)(nil),              // 77: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                // 78: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),              // 79: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                          // 80: google.protobuf.Any
+	(*proto.HelloRequest)(nil),                 // 81: greeter.HelloRequest
+	(*proto.HelloReply)(nil),                   // 82: greeter.HelloReply
+}
+var file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_depIdxs = []int32{
+	5,  // 0: service.HelloRequest.address:type_name -> service.Address
+	69, // 1: service.CreateResourceGroupRequest.Tags:type_name -> service.CreateResourceGroupRequest.TagsEntry
+	23, // 2: service.ReadResourceGroupReply.ResourceGroup:type_name -> service.ResourceGroup
+	70, // 3: service.UpdateResourceGroupRequest.Tags:type_name -> service.UpdateResourceGroupRequest.TagsEntry
+	0,  // 4: service.UpdateResourceGroupRequest.TagOperation:type_name -> service.TagOperation
+	77, // 5: service.UpdateResourceGroupRequest.UpdateMask:type_name -> google.protobuf.FieldMask
+	23, // 6: service.UpdateResourceGroupReply.ResourceGroup:type_name -> service.ResourceGroup
+	18, // 7: service.ExportResourceGroupTemplateReply.Errors:type_name -> service.ExportError
+	2,  // 8: service.DeleteResourceGroupEvent.State:type_name -> service.OperationState
+	78, // 9: service.DeleteResourceGroupEvent.Elapsed:type_name -> google.protobuf.Duration
+	23, // 10: service.ListResourceGroupsReply.resourceGroups:type_name -> service.ResourceGroup
+	71, // 11: service.ResourceGroup.Tags:type_name -> service.ResourceGroup.TagsEntry
+	26, // 12: service.ListResourcesReply.Resources:type_name -> service.Resource
+	72, // 13: service.Resource.Tags:type_name -> service.Resource.TagsEntry
+	26, // 14: service.GetResourceReply.Resource:type_name -> service.Resource
+	73, // 15: service.CreateOrUpdateResourceRequest.Tags:type_name -> service.CreateOrUpdateResourceRequest.TagsEntry
+	74, // 16: service.UpdateResourceRequest.Tags:type_name -> service.UpdateResourceRequest.TagsEntry
+	77, // 17: service.UpdateResourceRequest.UpdateMask:type_name -> google.protobuf.FieldMask
+	1,  // 18: service.DeployTemplateRequest.Mode:type_name -> service.DeploymentMode
+	1,  // 19: service.Deployment.Mode:type_name -> service.DeploymentMode
+	79, // 20: service.Deployment.Timestamp:type_name -> google.protobuf.Timestamp
+	78, // 21: service.Deployment.Duration:type_name -> google.protobuf.Duration
+	42, // 22: service.Deployment.Errors:type_name -> service.ARMError
+	42, // 23: service.ValidateDeploymentResult.Errors:type_name -> service.ARMError
+	42, // 24: service.WhatIfResult.Errors:type_name -> service.ARMError
+	46, // 25: service.WhatIfResult.Changes:type_name -> service.WhatIfChange
+	47, // 26: service.WhatIfChange.Delta:type_name -> service.PropertyChange
+	43, // 27: service.ListDeploymentsReply.Deployments:type_name -> service.Deployment
+	0,  // 28: service.PatchTagsAtScopeRequest.TagOperation:type_name -> service.TagOperation
+	75, // 29: service.PatchTagsAtScopeRequest.Tags:type_name -> service.PatchTagsAtScopeRequest.TagsEntry
+	76, // 30: service.TagsAtScope.Tags:type_name -> service.TagsAtScope.TagsEntry
+	56, // 31: service.ListTagNamesReply.TagNames:type_name -> service.TagName
+	57, // 32: service.TagName.Values:type_name -> service.TagValueCount
+	61, // 33: service.ListProvidersReply.Providers:type_name -> service.Provider
+	62, // 34: service.Provider.ResourceTypes:type_name -> service.ProviderResourceType
+	2,  // 35: service.Operation.State:type_name -> service.OperationState
+	79, // 36: service.Operation.CreateTime:type_name -> google.protobuf.Timestamp
+	79, // 37: service.Operation.UpdateTime:type_name -> google.protobuf.Timestamp
+	80, // 38: service.Operation.Result:type_name -> google.protobuf.Any
+	78, // 39: service.WaitOperationRequest.Timeout:type_name -> google.protobuf.Duration
+	63, // 40: service.ListOperationsReply.Operations:type_name -> service.Operation
+	81, // 41: service.RPC.SayHello:input_type -> greeter.HelloRequest
+	6,  // 42: service.RPC.CreateResourceGroup:input_type -> service.CreateResourceGroupRequest
+	8,  // 43: service.RPC.ReadResourceGroup:input_type -> service.ReadResourceGroupRequest
+	10, // 44: service.RPC.ResourceGroupExists:input_type -> service.ResourceGroupExistsRequest
+	12, // 45: service.RPC.UpdateResourceGroup:input_type -> service.UpdateResourceGroupRequest
+	14, // 46: service.RPC.DeleteResourceGroup:input_type -> service.DeleteResourceGroupRequest
+	16, // 47: service.RPC.ExportResourceGroupTemplate:input_type -> service.ExportResourceGroupTemplateRequest
+	14, // 48: service.RPC.DeleteResourceGroupWatch:input_type -> service.DeleteResourceGroupRequest
+	20, // 49: service.RPC.ListResourceGroups:input_type -> service.ListResourceGroupsRequest
+	22, // 50: service.RPC.StreamResourceGroups:input_type -> service.StreamResourceGroupsRequest
+	24, // 51: service.RPC.ListResources:input_type -> service.ListResourcesRequest
+	27, // 52: service.RPC.GetResource:input_type -> service.GetResourceRequest
+	29, // 53: service.RPC.CreateOrUpdateResource:input_type -> service.CreateOrUpdateResourceRequest
+	31, // 54: service.RPC.UpdateResource:input_type -> service.UpdateResourceRequest
+	33, // 55: service.RPC.DeleteResource:input_type -> service.DeleteResourceRequest
+	35, // 56: service.RPC.ValidateMoveResources:input_type -> service.MoveResourcesRequest
+	35, // 57: service.RPC.MoveResources:input_type -> service.MoveResourcesRequest
+	38, // 58: service.RPC.DeployTemplate:input_type -> service.DeployTemplateRequest
+	38, // 59: service.RPC.ValidateDeployment:input_type -> service.DeployTemplateRequest
+	38, // 60: service.RPC.WhatIfDeployment:input_type -> service.DeployTemplateRequest
+	48, // 61: service.RPC.GetDeployment:input_type -> service.GetDeploymentRequest
+	49, // 62: service.RPC.ListDeployments:input_type -> service.ListDeploymentsRequest
+	51, // 63: service.RPC.GetTagsAtScope:input_type -> service.GetTagsAtScopeRequest
+	52, // 64: service.RPC.PatchTagsAtScope:input_type -> service.PatchTagsAtScopeRequest
+	54, // 65: service.RPC.ListTagNames:input_type -> service.ListTagNamesRequest
+	58, // 66: service.RPC.ListProviders:input_type -> service.ListProvidersRequest
+	60, // 67: service.RPC.GetProvider:input_type -> service.ProviderRequest
+	60, // 68: service.RPC.RegisterProvider:input_type -> service.ProviderRequest
+	60, // 69: service.RPC.UnregisterProvider:input_type -> service.ProviderRequest
+	64, // 70: service.RPC.GetOperation:input_type -> service.GetOperationRequest
+	65, // 71: service.RPC.WaitOperation:input_type -> service.WaitOperationRequest
+	66, // 72: service.RPC.ListOperations:input_type -> service.ListOperationsRequest
+	68, // 73: service.RPC.CancelOperation:input_type -> service.CancelOperationRequest
+	82, // 74: service.RPC.SayHello:output_type -> greeter.HelloReply
+	7,  // 75: service.RPC.CreateResourceGroup:output_type -> service.CreateResourceGroupReply
+	9,  // 76: service.RPC.ReadResourceGroup:output_type -> service.ReadResourceGroupReply
+	11, // 77: service.RPC.ResourceGroupExists:output_type -> service.ResourceGroupExistsReply
+	13, // 78: service.RPC.UpdateResourceGroup:output_type -> service.UpdateResourceGroupReply
+	15, // 79: service.RPC.DeleteResourceGroup:output_type -> service.DeleteResourceGroupReply
+	17, // 80: service.RPC.ExportResourceGroupTemplate:output_type -> service.ExportResourceGroupTemplateReply
+	19, // 81: service.RPC.DeleteResourceGroupWatch:output_type -> service.DeleteResourceGroupEvent
+	21, // 82: service.RPC.ListResourceGroups:output_type -> service.ListResourceGroupsReply
+	23, // 83: service.RPC.StreamResourceGroups:output_type -> service.ResourceGroup
+	25, // 84: service.RPC.ListResources:output_type -> service.ListResourcesReply
+	28, // 85: service.RPC.GetResource:output_type -> service.GetResourceReply
+	30, // 86: service.RPC.CreateOrUpdateResource:output_type -> service.CreateOrUpdateResourceReply
+	32, // 87: service.RPC.UpdateResource:output_type -> service.UpdateResourceReply
+	34, // 88: service.RPC.DeleteResource:output_type -> service.DeleteResourceReply
+	36, // 89: service.RPC.ValidateMoveResources:output_type -> service.ValidateMoveResourcesReply
+	37, // 90: service.RPC.MoveResources:output_type -> service.MoveResourcesReply
+	39, // 91: service.RPC.DeployTemplate:output_type -> service.DeployTemplateReply
+	40, // 92: service.RPC.ValidateDeployment:output_type -> service.ValidateDeploymentReply
+	41, // 93: service.RPC.WhatIfDeployment:output_type -> service.WhatIfDeploymentReply
+	43, // 94: service.RPC.GetDeployment:output_type -> service.Deployment
+	50, // 95: service.RPC.ListDeployments:output_type -> service.ListDeploymentsReply
+	53, // 96: service.RPC.GetTagsAtScope:output_type -> service.TagsAtScope
+	53, // 97: service.RPC.PatchTagsAtScope:output_type -> service.TagsAtScope
+	55, // 98: service.RPC.ListTagNames:output_type -> service.ListTagNamesReply
+	59, // 99: service.RPC.ListProviders:output_type -> service.ListProvidersReply
+	61, // 100: service.RPC.GetProvider:output_type -> service.Provider
+	61, // 101: service.RPC.RegisterProvider:output_type -> service.Provider
+	61, // 102: service.RPC.UnregisterProvider:output_type -> service.Provider
+	63, // 103: service.RPC.GetOperation:output_type -> service.Operation
+	63, // 104: service.RPC.WaitOperation:output_type -> service.Operation
+	67, // 105: service.RPC.ListOperations:output_type -> service.ListOperationsReply
+	63, // 106: service.RPC.CancelOperation:output_type -> service.Operation
+	74, // [74:107] is the sub-list for method output_type
+	41, // [41:74] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_init()
+}
+func file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_init() {
+	if File_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelloRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelloReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResourceGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResourceGroupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadResourceGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadResourceGroupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceGroupExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceGroupExistsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResourceGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResourceGroupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceGroupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResourceGroupTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResourceGroupTemplateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceGroupEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourceGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourceGroupsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResourceGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourcesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrUpdateResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrUpdateResourceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResourceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateMoveResourcesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveResourcesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployTemplateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateDeploymentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhatIfDeploymentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ARMError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -949,8 +6206,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelloReply); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deployment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -961,8 +6218,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateDeploymentResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -973,8 +6230,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResourceGroupRequest); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhatIfResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -985,8 +6242,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResourceGroupReply); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhatIfChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -997,8 +6254,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadResourceGroupRequest); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropertyChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1009,8 +6266,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadResourceGroupReply); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeploymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1021,8 +6278,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateResourceGroupRequest); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1033,8 +6290,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateResourceGroupReply); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1045,8 +6302,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteResourceGroupRequest); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagsAtScopeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1057,8 +6314,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteResourceGroupReply); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchTagsAtScopeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1069,8 +6326,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResourceGroupsRequest); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagsAtScope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1081,8 +6338,8 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResourceGroupsReply); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagNamesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1093,8 +6350,164 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 				return nil
 			}
 		}
-		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceGroup); i {
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagNamesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagValueCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderResourceType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOperationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1111,13 +6524,14 @@ func file_github_com_element_of_surprise_examples_testing_servwithclients_server
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      3,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_goTypes,
 		DependencyIndexes: file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_depIdxs,
+		EnumInfos:         file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_enumTypes,
 		MessageInfos:      file_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto_msgTypes,
 	}.Build()
 	File_github_com_element_of_surprise_examples_testing_servwithclients_server_proto_server_proto = out.File
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// ListProviders lists the resource providers available to a subscription.
+func (s *Server) ListProviders(ctx context.Context, in *pb.ListProvidersRequest) (*pb.ListProvidersReply, error) {
+	size, err := pageSize(in.GetPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sub := in.GetSubscription()
+	if sub == "" {
+		sub = s.subscriptionID
+	}
+	tok, err := s.requestPageToken(in.GetPageToken(), scopeHash("/subscriptions/"+sub+"/providers"))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.providersClientFor(in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+	page := func(p armresources.ProvidersClientListResponse) ([]*armresources.Provider, *string) {
+		return p.Value, p.NextLink
+	}
+	items, next, err := readPage(ctx, client.NewListPager(nil), tok, size, page)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListProvidersReply{Providers: make([]*pb.Provider, 0, len(items))}
+	for _, p := range items {
+		reply.Providers = append(reply.Providers, toPBProvider(*p))
+	}
+	if reply.NextPageToken, err = s.nextPageToken(next); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GetProvider reads a resource provider.
+func (s *Server) GetProvider(ctx context.Context, in *pb.ProviderRequest) (*pb.Provider, error) {
+	client, err := s.providerRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, in.GetNamespace(), nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	return toPBProvider(resp.Provider), nil
+}
+
+// RegisterProvider registers the subscription with a resource provider. ARM registers the provider
+// in the background, so the returned provider is usually still "Registering".
+func (s *Server) RegisterProvider(ctx context.Context, in *pb.ProviderRequest) (*pb.Provider, error) {
+	client, err := s.providerRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Register(ctx, in.GetNamespace(), nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	return toPBProvider(resp.Provider), nil
+}
+
+// UnregisterProvider unregisters the subscription from a resource provider. ARM unregisters the provider
+// in the background, so the returned provider is usually still "Unregistering".
+func (s *Server) UnregisterProvider(ctx context.Context, in *pb.ProviderRequest) (*pb.Provider, error) {
+	client, err := s.providerRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Unregister(ctx, in.GetNamespace(), nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	return toPBProvider(resp.Provider), nil
+}
+
+// providerRequest validates a ProviderRequest and returns the client for its subscription.
+func (s *Server) providerRequest(in *pb.ProviderRequest) (providersClient, error) {
+	v := violations{}
+	validateProviderNamespace(&v, "Namespace", in.GetNamespace())
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return s.providersClientFor(in.GetSubscription())
+}
+
+// toPBProvider converts an armresources.Provider to the proto representation.
+func toPBProvider(p armresources.Provider) *pb.Provider {
+	pp := &pb.Provider{
+		Id:                 deref(p.ID),
+		Namespace:          deref(p.Namespace),
+		RegistrationState:  deref(p.RegistrationState),
+		RegistrationPolicy: deref(p.RegistrationPolicy),
+	}
+	for _, rt := range p.ResourceTypes {
+		if rt == nil {
+			continue
+		}
+		pp.ResourceTypes = append(pp.ResourceTypes, &pb.ProviderResourceType{
+			Type:              deref(rt.ResourceType),
+			Regions:           derefAll(rt.Locations),
+			ApiVersions:       derefAll(rt.APIVersions),
+			DefaultApiVersion: deref(rt.DefaultAPIVersion),
+		})
+	}
+	return pp
+}
+
+// derefAll returns the values in ps that are not nil.
+func derefAll[T any](ps []*T) []T {
+	var out []T
+	for _, p := range ps {
+		if p != nil {
+			out = append(out, *p)
+		}
+	}
+	return out
+}
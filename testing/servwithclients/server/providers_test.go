@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func TestGetProvider(t *testing.T) {
+	t.Parallel()
+
+	network := armresources.ProvidersClientGetResponse{
+		Provider: armresources.Provider{
+			ID:                 toPtr("/subscriptions/subscriptionID/providers/Microsoft.Network"),
+			Namespace:          toPtr("Microsoft.Network"),
+			RegistrationState:  toPtr("Registered"),
+			RegistrationPolicy: toPtr("RegistrationRequired"),
+			ResourceTypes: []*armresources.ProviderResourceType{
+				{
+					ResourceType:      toPtr("virtualNetworks"),
+					Locations:         []*string{toPtr("West US"), nil, toPtr("East US")},
+					APIVersions:       []*string{toPtr("2023-05-01"), toPtr("2023-04-01")},
+					DefaultAPIVersion: toPtr("2020-03-01"),
+				},
+				nil,
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		req       *pb.ProviderRequest
+		fakeCalls *fakeProvidersCalls
+		noClient  bool
+		wantCode  codes.Code
+		want      *pb.Provider
+	}{
+		{
+			name:      "Error: no namespace",
+			req:       &pb.ProviderRequest{},
+			fakeCalls: &fakeProvidersCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: invalid namespace",
+			req:       &pb.ProviderRequest{Namespace: "Microsoft/Network"},
+			fakeCalls: &fakeProvidersCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: other subscription",
+			req:       &pb.ProviderRequest{Subscription: "other", Namespace: "Microsoft.Network"},
+			fakeCalls: &fakeProvidersCalls{},
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			name:     "Error: no providers client",
+			req:      &pb.ProviderRequest{Namespace: "Microsoft.Network"},
+			noClient: true,
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name:      "Error: Get() returned an error",
+			req:       &pb.ProviderRequest{Namespace: "Microsoft.Network"},
+			fakeCalls: &fakeProvidersCalls{get: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name:      "Success",
+			req:       &pb.ProviderRequest{Namespace: "Microsoft.Network"},
+			fakeCalls: &fakeProvidersCalls{get: []any{network}},
+			want: &pb.Provider{
+				Id:                 "/subscriptions/subscriptionID/providers/Microsoft.Network",
+				Namespace:          "Microsoft.Network",
+				RegistrationState:  "Registered",
+				RegistrationPolicy: "RegistrationRequired",
+				ResourceTypes: []*pb.ProviderResourceType{
+					{
+						Type:              "virtualNetworks",
+						Regions:           []string{"West US", "East US"},
+						ApiVersions:       []string{"2023-05-01", "2023-04-01"},
+						DefaultApiVersion: "2020-03-01",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{subscriptionID: "subscriptionID"}
+		if !test.noClient {
+			s.providersClient = mustFakeProvidersClient(test.fakeCalls)
+		}
+		got, err := s.GetProvider(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestGetProvider(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestGetProvider(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestProviderRegistration(t *testing.T) {
+	t.Parallel()
+
+	provider := func(state string) armresources.Provider {
+		return armresources.Provider{Namespace: toPtr("Microsoft.Network"), RegistrationState: toPtr(state)}
+	}
+
+	tests := []struct {
+		name      string
+		call      func(s *Server, req *pb.ProviderRequest) (*pb.Provider, error)
+		fakeCalls *fakeProvidersCalls
+		wantCode  codes.Code
+		wantState string
+	}{
+		{
+			name: "Register: error",
+			call: func(s *Server, req *pb.ProviderRequest) (*pb.Provider, error) {
+				return s.RegisterProvider(context.Background(), req)
+			},
+			fakeCalls: &fakeProvidersCalls{register: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name: "Register: success",
+			call: func(s *Server, req *pb.ProviderRequest) (*pb.Provider, error) {
+				return s.RegisterProvider(context.Background(), req)
+			},
+			fakeCalls: &fakeProvidersCalls{register: []any{armresources.ProvidersClientRegisterResponse{Provider: provider("Registering")}}},
+			wantState: "Registering",
+		},
+		{
+			name: "Unregister: error",
+			call: func(s *Server, req *pb.ProviderRequest) (*pb.Provider, error) {
+				return s.UnregisterProvider(context.Background(), req)
+			},
+			fakeCalls: &fakeProvidersCalls{unregister: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name: "Unregister: success",
+			call: func(s *Server, req *pb.ProviderRequest) (*pb.Provider, error) {
+				return s.UnregisterProvider(context.Background(), req)
+			},
+			fakeCalls: &fakeProvidersCalls{unregister: []any{armresources.ProvidersClientUnregisterResponse{Provider: provider("Unregistering")}}},
+			wantState: "Unregistering",
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{providersClient: mustFakeProvidersClient(test.fakeCalls)}
+		got, err := test.call(s, &pb.ProviderRequest{Namespace: "Microsoft.Network"})
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestProviderRegistration(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got.Namespace != "Microsoft.Network" || got.RegistrationState != test.wantState {
+			t.Errorf("TestProviderRegistration(%s): got %s in state %q, want Microsoft.Network in state %q", test.name, got.Namespace, got.RegistrationState, test.wantState)
+		}
+	}
+}
+
+func TestListProviders(t *testing.T) {
+	t.Parallel()
+
+	listPage := func(namespaces ...string) armresources.ProvidersClientListResponse {
+		page := armresources.ProvidersClientListResponse{}
+		for _, n := range namespaces {
+			page.Value = append(page.Value, &armresources.Provider{Namespace: toPtr(n)})
+		}
+		return page
+	}
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeProvidersCalls
+		wantCode  codes.Code
+		want      [][]string
+	}{
+		{
+			name:      "Error: pager returned an error",
+			fakeCalls: &fakeProvidersCalls{list: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name:      "Page ends inside an ARM page",
+			fakeCalls: &fakeProvidersCalls{list: []any{listPage("Microsoft.Compute", "Microsoft.Network", "Microsoft.Storage")}},
+			want:      [][]string{{"Microsoft.Compute", "Microsoft.Network"}, {"Microsoft.Storage"}},
+		},
+		{
+			name:      "Page ends on an ARM page boundary",
+			fakeCalls: &fakeProvidersCalls{list: []any{listPage("Microsoft.Compute", "Microsoft.Network"), listPage("Microsoft.Storage")}},
+			want:      [][]string{{"Microsoft.Compute", "Microsoft.Network"}, {"Microsoft.Storage"}},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{providersClient: mustFakeProvidersClient(test.fakeCalls)}
+
+		req := &pb.ListProvidersRequest{PageSize: 2}
+		var got [][]string
+		var err error
+		for i := 0; i < 10; i++ {
+			var resp *pb.ListProvidersReply
+			resp, err = s.ListProviders(context.Background(), req)
+			if err != nil {
+				break
+			}
+			var namespaces []string
+			for _, p := range resp.Providers {
+				namespaces = append(namespaces, p.Namespace)
+			}
+			got = append(got, namespaces)
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestListProviders(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("TestListProviders(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rgRef identifies a resource group.
+type rgRef struct {
+	// subscription is the subscription of the resource group. It is empty if the
+	// resource group was given by name, which means the server's subscription.
+	subscription string
+	name         string
+}
+
+// parseRGRef parses ref, which is either the name of a resource group or the ARM ID of a
+// resource group, such as "/subscriptions/<subscription>/resourceGroups/<name>".
+// Problems are added to v as violations of field.
+func parseRGRef(v *violations, field, ref string) rgRef {
+	if !strings.HasPrefix(ref, "/") {
+		validateRGName(v, field, ref)
+		return rgRef{name: ref}
+	}
+
+	id, err := arm.ParseResourceID(ref)
+	if err != nil {
+		v.add(field, "%s", err)
+		return rgRef{}
+	}
+	if !strings.EqualFold(id.ResourceType.String(), arm.ResourceGroupResourceType.String()) {
+		v.add(field, "resource ID is for a %s, not a resource group", id.ResourceType)
+		return rgRef{}
+	}
+	validateRGName(v, field, id.Name)
+	return rgRef{subscription: id.SubscriptionID, name: id.Name}
+}
+
+// id returns the ARM ID of the resource group, or its name if the subscription is not known.
+func (r rgRef) id() string {
+	if r.subscription == "" {
+		return r.name
+	}
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", r.subscription, r.name)
+}
+
+// clientsFor returns the clients for subscription. An empty subscription is the server's subscription.
+func (s *Server) clientsFor(subscription string) (*subscriptionClients, error) {
+	if subscription == "" || strings.EqualFold(subscription, s.subscriptionID) {
+		return &subscriptionClients{
+			groups:      s.resourceClient,
+			resources:   s.genericClient,
+			providers:   s.providersClient,
+			deployments: s.deploymentsClient,
+			tags:        s.tagsClient,
+		}, nil
+	}
+	if s.clients != nil {
+		return s.clients.get(subscription)
+	}
+	if s.subscriptionID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "server does not know its subscription, so cannot act on resources by ARM ID")
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "server does not manage subscription %q", subscription)
+}
+
+// clientFor returns the resourceClient for subscription. An empty subscription is the server's subscription.
+func (s *Server) clientFor(subscription string) (resourceClient, error) {
+	c, err := s.clientsFor(subscription)
+	if err != nil {
+		return nil, err
+	}
+	return c.groups, nil
+}
+
+// genericClientFor returns the genericClient for subscription. An empty subscription is the server's subscription.
+func (s *Server) genericClientFor(subscription string) (genericClient, error) {
+	c, err := s.clientsFor(subscription)
+	if err != nil {
+		return nil, err
+	}
+	if c.resources == nil {
+		return nil, status.Error(codes.Unimplemented, "server was not configured with a resources client, see WithClientFactory()")
+	}
+	return c.resources, nil
+}
+
+// providersClientFor returns the providersClient for subscription. An empty subscription is the server's subscription.
+func (s *Server) providersClientFor(subscription string) (providersClient, error) {
+	c, err := s.clientsFor(subscription)
+	if err != nil {
+		return nil, err
+	}
+	if c.providers == nil {
+		return nil, status.Error(codes.Unimplemented, "server was not configured with a providers client, see WithClientFactory()")
+	}
+	return c.providers, nil
+}
+
+// deploymentsClientFor returns the deploymentsClient for subscription. An empty subscription is the server's subscription.
+func (s *Server) deploymentsClientFor(subscription string) (deploymentsClient, error) {
+	c, err := s.clientsFor(subscription)
+	if err != nil {
+		return nil, err
+	}
+	if c.deployments == nil {
+		return nil, status.Error(codes.Unimplemented, "server was not configured with a deployments client, see WithClientFactory()")
+	}
+	return c.deployments, nil
+}
+
+// tagsClientFor returns the tagsClient for subscription. An empty subscription is the server's subscription.
+func (s *Server) tagsClientFor(subscription string) (tagsClient, error) {
+	c, err := s.clientsFor(subscription)
+	if err != nil {
+		return nil, err
+	}
+	if c.tags == nil {
+		return nil, status.Error(codes.Unimplemented, "server was not configured with a tags client, see WithClientFactory()")
+	}
+	return c.tags, nil
+}
+
+// resourceGroup resolves ref, the name or ARM ID of a resource group given in field, to the
+// client for its subscription. subscription is the subscription given in the request, if any,
+// which must match the subscription in ref if ref is an ID.
+func (s *Server) resourceGroup(field, ref, subscription string) (resourceClient, rgRef, error) {
+	rg, err := parseRGRequest(field, ref, subscription)
+	if err != nil {
+		return nil, rgRef{}, err
+	}
+
+	client, err := s.clientFor(rg.subscription)
+	if err != nil {
+		return nil, rgRef{}, err
+	}
+	return client, rg, nil
+}
+
+// parseRGRequest parses ref, the name or ARM ID of a resource group given in field. subscription is the
+// subscription given in the request, if any, which must match the subscription in ref if ref is an ID.
+func parseRGRequest(field, ref, subscription string) (rgRef, error) {
+	v := violations{}
+	rg := parseRGRef(&v, field, ref)
+	switch {
+	case subscription == "":
+	case rg.subscription == "":
+		rg.subscription = subscription
+	case !strings.EqualFold(rg.subscription, subscription):
+		v.add("Subscription", "does not match the subscription in %s", field)
+	}
+	if err := v.err(); err != nil {
+		return rgRef{}, err
+	}
+	return rg, nil
+}
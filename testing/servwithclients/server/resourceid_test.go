@@ -0,0 +1,123 @@
+package server
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResourceGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		subscription string
+		ref          string
+		reqSub       string
+		pool         []string
+		wantName     string
+		wantID       string
+		wantCode     codes.Code
+	}{
+		{
+			name:         "Error: invalid name",
+			subscription: "sub",
+			ref:          "bad name!",
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:         "Error: malformed ID",
+			subscription: "sub",
+			ref:          "/subscriptions",
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:         "Error: ID is not a resource group",
+			subscription: "sub",
+			ref:          "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:     "Error: server has no subscription",
+			ref:      "/subscriptions/sub/resourceGroups/rg",
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "Error: ID is for another subscription",
+			subscription: "sub",
+			ref:          "/subscriptions/other/resourceGroups/rg",
+			wantCode:     codes.PermissionDenied,
+		},
+		{
+			name:         "Error: request subscription does not match ID",
+			subscription: "sub",
+			ref:          "/subscriptions/sub/resourceGroups/rg",
+			reqSub:       "other",
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:         "Error: request subscription not allowed",
+			subscription: "sub",
+			ref:          "rg",
+			reqSub:       "other",
+			pool:         []string{"another"},
+			wantCode:     codes.PermissionDenied,
+		},
+		{
+			name:         "Success: request subscription in pool",
+			subscription: "sub",
+			ref:          "rg",
+			reqSub:       "other",
+			pool:         []string{"other"},
+			wantName:     "rg",
+			wantID:       "/subscriptions/other/resourceGroups/rg",
+		},
+		{
+			name:         "Success: ID in pool",
+			subscription: "sub",
+			ref:          "/subscriptions/other/resourceGroups/rg",
+			pool:         []string{"other"},
+			wantName:     "rg",
+			wantID:       "/subscriptions/other/resourceGroups/rg",
+		},
+		{
+			name:     "Success: name without subscription",
+			ref:      "rg",
+			wantName: "rg",
+			wantID:   "rg",
+		},
+		{
+			name:         "Success: ID",
+			subscription: "SUB",
+			ref:          "/subscriptions/sub/resourceGroups/rg",
+			wantName:     "rg",
+			wantID:       "/subscriptions/sub/resourceGroups/rg",
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{subscriptionID: test.subscription}
+		if test.pool != nil {
+			newClients := func(string) (*subscriptionClients, error) {
+				return &subscriptionClients{groups: mustFakeResourceGroupClient(&fakeResourceCalls{})}, nil
+			}
+			pool, err := newClientPool(newClients, test.pool)
+			if err != nil {
+				panic(err)
+			}
+			s.clients = pool
+		}
+		_, got, err := s.resourceGroup("Id", test.ref, test.reqSub)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestResourceGroup(%s): got code %v, want %v", test.name, status.Code(err), test.wantCode)
+			continue
+		}
+		if got.name != test.wantName {
+			t.Errorf("TestResourceGroup(%s): got name %q, want %q", test.name, got.name, test.wantName)
+		}
+		if got.id() != test.wantID && err == nil {
+			t.Errorf("TestResourceGroup(%s): got id %q, want %q", test.name, got.id(), test.wantID)
+		}
+	}
+}
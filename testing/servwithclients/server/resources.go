@@ -0,0 +1,376 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// ListResources lists the resources in a resource group.
+func (s *Server) ListResources(ctx context.Context, in *pb.ListResourcesRequest) (*pb.ListResourcesReply, error) {
+	size, err := pageSize(in.GetPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rg, err := parseRGRequest("ResourceGroup", in.GetResourceGroup(), in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+	tok, err := s.requestPageToken(in.GetPageToken(), scopeHash(rg.id()))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.genericClientFor(rg.subscription)
+	if err != nil {
+		return nil, err
+	}
+	page := func(p armresources.ClientListByResourceGroupResponse) ([]*armresources.GenericResourceExpanded, *string) {
+		return p.Value, p.NextLink
+	}
+	items, next, err := readPage(ctx, client.NewListByResourceGroupPager(rg.name, nil), tok, size, page)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListResourcesReply{Resources: make([]*pb.Resource, 0, len(items))}
+	for _, r := range items {
+		reply.Resources = append(reply.Resources, toPBResource(*r))
+	}
+	if reply.NextPageToken, err = s.nextPageToken(next); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// scopeHash identifies the scope being listed, so that a page token can only be used to list the scope that created it.
+func scopeHash(scope string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(scope)))
+	return base64.RawURLEncoding.EncodeToString(h[:8])
+}
+
+// toPBResource converts an armresources.GenericResourceExpanded to the proto representation.
+func toPBResource(r armresources.GenericResourceExpanded) *pb.Resource {
+	return &pb.Resource{
+		Id:     deref(r.ID),
+		Name:   deref(r.Name),
+		Type:   deref(r.Type),
+		Region: deref(r.Location),
+		Kind:   deref(r.Kind),
+		Tags:   toPBTags(r.Tags),
+	}
+}
+
+// GetResource reads any resource by its ARM ID.
+func (s *Server) GetResource(ctx context.Context, in *pb.GetResourceRequest) (*pb.GetResourceReply, error) {
+	id, err := parseResourceID("Id", in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.genericClientFor(id.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.apiVersion(ctx, id, in.GetApiVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetByID(ctx, in.GetId(), version, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	r, err := toPBGenericResource(resp.GenericResource)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.GetResourceReply{Resource: r, ApiVersion: version}, nil
+}
+
+// CreateOrUpdateResource starts creating or replacing any resource by its ARM ID and returns the ID of the
+// long-running operation doing it. The result of the operation is the resulting Resource.
+func (s *Server) CreateOrUpdateResource(ctx context.Context, in *pb.CreateOrUpdateResourceRequest) (*pb.CreateOrUpdateResourceReply, error) {
+	id, err := parseResourceID("Id", in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	props, err := parseProperties("Properties", in.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.genericClientFor(id.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.apiVersion(ctx, id, in.GetApiVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	params := armresources.GenericResource{
+		Location:   optional(in.GetRegion()),
+		Kind:       optional(in.GetKind()),
+		ManagedBy:  optional(in.GetManagedBy()),
+		Tags:       toAzTags(in.GetTags()),
+		Properties: props,
+	}
+	poll, err := client.BeginCreateOrUpdateByID(ctx, in.GetId(), version, params, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opCreateOrUpdateResource, in.GetId(), createResourcePoller(poll))
+	s.invalidateIfGroup(id, op)
+
+	return &pb.CreateOrUpdateResourceReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// UpdateResource starts patching any resource by its ARM ID and returns the ID of the long-running
+// operation doing it. The result of the operation is the resulting Resource.
+func (s *Server) UpdateResource(ctx context.Context, in *pb.UpdateResourceRequest) (*pb.UpdateResourceReply, error) {
+	id, err := parseResourceID("Id", in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	fields, err := resourceUpdateFields(in.GetUpdateMask())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	patch := armresources.GenericResource{}
+	if fields.tags {
+		// An empty map, rather than nil, so that clearing the tags is sent to ARM.
+		patch.Tags = map[string]*string{}
+		for k, v := range toAzTags(in.GetTags()) {
+			patch.Tags[k] = v
+		}
+	}
+	if fields.properties {
+		patch.Properties, err = parseProperties("Properties", in.GetProperties())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := s.genericClientFor(id.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.apiVersion(ctx, id, in.GetApiVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := client.BeginUpdateByID(ctx, in.GetId(), version, patch, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opUpdateResource, in.GetId(), updateResourcePoller(poll))
+	s.invalidateIfGroup(id, op)
+
+	return &pb.UpdateResourceReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// DeleteResource starts deleting any resource by its ARM ID and returns the ID of the long-running
+// operation doing the delete.
+func (s *Server) DeleteResource(ctx context.Context, in *pb.DeleteResourceRequest) (*pb.DeleteResourceReply, error) {
+	id, err := parseResourceID("Id", in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.genericClientFor(id.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.apiVersion(ctx, id, in.GetApiVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := client.BeginDeleteByID(ctx, in.GetId(), version, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	op := s.ops.start(opDeleteResource, in.GetId(), armPoller[armresources.ClientDeleteByIDResponse]{Poller: poll})
+	s.invalidateIfGroup(id, op)
+
+	return &pb.DeleteResourceReply{Status: "Running", OperationId: op.proto().Id}, nil
+}
+
+// invalidateIfGroup removes the resource group from the cache if id is the ID of one, which op changes. It is removed
+// again when op is done, as reads made while op is running cache the group as it was.
+func (s *Server) invalidateIfGroup(id *arm.ResourceID, op *operation) {
+	if !strings.EqualFold(id.ResourceType.String(), arm.ResourceGroupResourceType.String()) {
+		return
+	}
+	s.invalidateGroup(id.SubscriptionID, id.ResourceGroupName)
+	op.onFinish(func() { s.invalidateGroup(id.SubscriptionID, id.ResourceGroupName) })
+}
+
+// apiVersion returns version if it is set. Otherwise it resolves the API version for the type of id through
+// the providers API.
+func (s *Server) apiVersion(ctx context.Context, id *arm.ResourceID, version string) (string, error) {
+	if version != "" {
+		return version, nil
+	}
+	c, err := s.clientsFor(id.SubscriptionID)
+	if err != nil {
+		return "", err
+	}
+	if c.providers == nil {
+		return "", status.Error(codes.FailedPrecondition, "server cannot resolve API versions, ApiVersion must be set")
+	}
+	return s.apiVersions.resolve(ctx, c.providers, id.SubscriptionID, id.ResourceType)
+}
+
+// parseResourceID parses the ARM ID of a subscription, or of a resource group or resource in a subscription,
+// given in field. IDs of tenant or management group scopes are rejected: they have no subscription to route
+// the request with.
+func parseResourceID(field, id string) (*arm.ResourceID, error) {
+	v := violations{}
+	rid, err := arm.ParseResourceID(id)
+	switch {
+	case err != nil:
+		v.add(field, "%s", err)
+	case rid.SubscriptionID == "":
+		v.add(field, "must be a subscription or be inside one")
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return rid, nil
+}
+
+// parseProperties decodes the JSON resource properties given in field. Empty properties are nil.
+func parseProperties(field, props string) (any, error) {
+	if props == "" {
+		return nil, nil
+	}
+	var p any
+	if err := json.Unmarshal([]byte(props), &p); err != nil {
+		v := violations{}
+		v.add(field, "is not valid JSON: %s", err)
+		return nil, v.err()
+	}
+	return p, nil
+}
+
+// resourcePatchFields are the fields named in an UpdateResourceRequest.UpdateMask.
+type resourcePatchFields struct {
+	tags       bool
+	properties bool
+}
+
+// resourceUpdateFields validates the UpdateMask of an UpdateResourceRequest and returns the fields to patch.
+func resourceUpdateFields(mask *fieldmaskpb.FieldMask) (resourcePatchFields, error) {
+	pf := resourcePatchFields{}
+
+	if len(mask.GetPaths()) == 0 {
+		return pf, errors.New("UpdateMask must name at least one field")
+	}
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "Tags":
+			pf.tags = true
+		case "Properties":
+			pf.properties = true
+		default:
+			return pf, fmt.Errorf("UpdateMask: field %q cannot be updated", path)
+		}
+	}
+	return pf, nil
+}
+
+// createResourcePoller adapts the poller for a create so that its result is a Resource.
+func createResourcePoller(poll *runtime.Poller[armresources.ClientCreateOrUpdateByIDResponse]) lroPoller {
+	convert := func(r armresources.ClientCreateOrUpdateByIDResponse) (*anypb.Any, error) {
+		return resourceResult(r.GenericResource)
+	}
+	return armPoller[armresources.ClientCreateOrUpdateByIDResponse]{Poller: poll, convert: convert}
+}
+
+// updateResourcePoller adapts the poller for an update so that its result is a Resource.
+func updateResourcePoller(poll *runtime.Poller[armresources.ClientUpdateByIDResponse]) lroPoller {
+	convert := func(r armresources.ClientUpdateByIDResponse) (*anypb.Any, error) {
+		return resourceResult(r.GenericResource)
+	}
+	return armPoller[armresources.ClientUpdateByIDResponse]{Poller: poll, convert: convert}
+}
+
+func resourceResult(r armresources.GenericResource) (*anypb.Any, error) {
+	pr, err := toPBGenericResource(r)
+	if err != nil {
+		return nil, err
+	}
+	return anypb.New(pr)
+}
+
+// toPBGenericResource converts an armresources.GenericResource to the proto representation.
+func toPBGenericResource(r armresources.GenericResource) (*pb.Resource, error) {
+	pr := &pb.Resource{
+		Id:        deref(r.ID),
+		Name:      deref(r.Name),
+		Type:      deref(r.Type),
+		Region:    deref(r.Location),
+		Kind:      deref(r.Kind),
+		ManagedBy: deref(r.ManagedBy),
+		Tags:      toPBTags(r.Tags),
+	}
+	if r.Properties != nil {
+		b, err := json.Marshal(r.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal properties: %w", err)
+		}
+		pr.Properties = string(b)
+	}
+	return pr, nil
+}
+
+// resumeResourcePoller recreates the poller for an operation on a resource. When given a ResumeToken,
+// the *ByID methods don't send a request, so the API version and parameters are not needed.
+func (s *Server) resumeResourcePoller(kind, target, token string) (lroPoller, error) {
+	id, err := parseResourceID("Target", target)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.genericClientFor(id.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	switch kind {
+	case opCreateOrUpdateResource:
+		poll, err := client.BeginCreateOrUpdateByID(ctx, target, "", armresources.GenericResource{}, &armresources.ClientBeginCreateOrUpdateByIDOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return createResourcePoller(poll), nil
+	case opUpdateResource:
+		poll, err := client.BeginUpdateByID(ctx, target, "", armresources.GenericResource{}, &armresources.ClientBeginUpdateByIDOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return updateResourcePoller(poll), nil
+	case opDeleteResource:
+		poll, err := client.BeginDeleteByID(ctx, target, "", &armresources.ClientBeginDeleteByIDOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return armPoller[armresources.ClientDeleteByIDResponse]{Poller: poll}, nil
+	}
+	return nil, fmt.Errorf("unknown operation kind %q", kind)
+}
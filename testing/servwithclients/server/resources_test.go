@@ -0,0 +1,488 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources/fake"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func mustFakeGenericClient(calls *fakeGenericCalls) genericClient {
+	fs := newGenericServer(calls)
+	client, err := armresources.NewClient(
+		"subscriptionID",
+		&azfake.TokenCredential{},
+		&arm.ClientOptions{
+			ClientOptions: azcore.ClientOptions{
+				Transport: fake.NewServerTransport(&fs),
+			},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func TestListResources(t *testing.T) {
+	t.Parallel()
+
+	vnet := &armresources.GenericResourceExpanded{
+		ID:       toPtr("/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet"),
+		Name:     toPtr("vnet"),
+		Type:     toPtr("Microsoft.Network/virtualNetworks"),
+		Location: toPtr("westus"),
+		Tags:     map[string]*string{"owner": toPtr("bob")},
+	}
+	acct := &armresources.GenericResourceExpanded{
+		ID:       toPtr("/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/acct"),
+		Name:     toPtr("acct"),
+		Type:     toPtr("Microsoft.Storage/storageAccounts"),
+		Location: toPtr("westus"),
+		Kind:     toPtr("StorageV2"),
+	}
+	page := armresources.ClientListByResourceGroupResponse{
+		ResourceListResult: armresources.ResourceListResult{Value: []*armresources.GenericResourceExpanded{vnet, nil, acct}},
+	}
+
+	tests := []struct {
+		name      string
+		req       *pb.ListResourcesRequest
+		fakeCalls *fakeGenericCalls
+		noClient  bool
+		wantCode  codes.Code
+		want      []*pb.Resource
+	}{
+		{
+			name:      "Error: invalid resource group",
+			req:       &pb.ListResourcesRequest{ResourceGroup: "bad name!"},
+			fakeCalls: &fakeGenericCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:     "Error: no resources client",
+			req:      &pb.ListResourcesRequest{ResourceGroup: "rg"},
+			noClient: true,
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name:      "Error: pager returned an error",
+			req:       &pb.ListResourcesRequest{ResourceGroup: "rg"},
+			fakeCalls: &fakeGenericCalls{listByResourceGroup: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name:      "Success",
+			req:       &pb.ListResourcesRequest{ResourceGroup: "rg"},
+			fakeCalls: &fakeGenericCalls{listByResourceGroup: []any{page}},
+			want: []*pb.Resource{
+				{
+					Id:     "/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+					Name:   "vnet",
+					Type:   "Microsoft.Network/virtualNetworks",
+					Region: "westus",
+					Tags:   map[string]string{"owner": "bob"},
+				},
+				{
+					Id:     "/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/acct",
+					Name:   "acct",
+					Type:   "Microsoft.Storage/storageAccounts",
+					Region: "westus",
+					Kind:   "StorageV2",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{}
+		if !test.noClient {
+			s.genericClient = mustFakeGenericClient(test.fakeCalls)
+		}
+		got, err := s.ListResources(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestListResources(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got.Resources, protocmp.Transform()); diff != "" {
+			t.Errorf("TestListResources(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestListResourcesPaging(t *testing.T) {
+	t.Parallel()
+
+	listPage := func(names ...string) armresources.ClientListByResourceGroupResponse {
+		page := armresources.ClientListByResourceGroupResponse{}
+		for _, n := range names {
+			page.Value = append(page.Value, &armresources.GenericResourceExpanded{Name: toPtr(n)})
+		}
+		return page
+	}
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeGenericCalls
+		want      [][]string
+	}{
+		{
+			name:      "Page ends inside an ARM page",
+			fakeCalls: &fakeGenericCalls{listByResourceGroup: []any{listPage("a", "b", "c")}},
+			want:      [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name:      "Page ends on an ARM page boundary",
+			fakeCalls: &fakeGenericCalls{listByResourceGroup: []any{listPage("a", "b"), listPage("c", "d")}},
+			want:      [][]string{{"a", "b"}, {"c", "d"}},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{genericClient: mustFakeGenericClient(test.fakeCalls)}
+
+		req := &pb.ListResourcesRequest{ResourceGroup: "rg", PageSize: 2}
+		var got [][]string
+		for i := 0; i < 10; i++ {
+			resp, err := s.ListResources(context.Background(), req)
+			if err != nil {
+				t.Fatalf("TestListResourcesPaging(%s): got err == %s, want err == nil", test.name, err)
+			}
+			var names []string
+			for _, r := range resp.Resources {
+				names = append(names, r.Name)
+			}
+			got = append(got, names)
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("TestListResourcesPaging(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+
+	s := &Server{genericClient: mustFakeGenericClient(&fakeGenericCalls{})}
+	tok, err := s.encodePageToken(pageToken{Filter: scopeHash("other")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.ListResources(context.Background(), &pb.ListResourcesRequest{ResourceGroup: "rg", PageToken: tok})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestListResourcesPaging(token for other resource group): got code %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+const vnetID = "/subscriptions/subscriptionID/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet"
+
+// networkProvider is the Microsoft.Network provider as returned by the providers API.
+var networkProvider = armresources.ProvidersClientGetResponse{
+	Provider: armresources.Provider{
+		Namespace: toPtr("Microsoft.Network"),
+		ResourceTypes: []*armresources.ProviderResourceType{
+			{ResourceType: toPtr("virtualNetworks"), APIVersions: []*string{toPtr("2023-04-01")}},
+		},
+	},
+}
+
+func TestGetResource(t *testing.T) {
+	t.Parallel()
+
+	vnet := armresources.GenericResource{
+		ID:         toPtr(vnetID),
+		Name:       toPtr("vnet"),
+		Type:       toPtr("Microsoft.Network/virtualNetworks"),
+		Location:   toPtr("westus"),
+		Properties: map[string]any{"addressSpace": map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}}},
+	}
+
+	tests := []struct {
+		name           string
+		req            *pb.GetResourceRequest
+		fakeCalls      *fakeGenericCalls
+		providerCalls  *fakeProvidersCalls
+		wantCode       codes.Code
+		wantAPIVersion string
+		want           *pb.GetResourceReply
+	}{
+		{
+			name:          "Error: invalid ID",
+			req:           &pb.GetResourceRequest{Id: "vnet"},
+			fakeCalls:     &fakeGenericCalls{},
+			providerCalls: &fakeProvidersCalls{},
+			wantCode:      codes.InvalidArgument,
+		},
+		{
+			name:          "Error: ID is not in a subscription",
+			req:           &pb.GetResourceRequest{Id: "/providers/Microsoft.Management/managementGroups/mg"},
+			fakeCalls:     &fakeGenericCalls{},
+			providerCalls: &fakeProvidersCalls{},
+			wantCode:      codes.InvalidArgument,
+		},
+		{
+			name:          "Error: API version can't be resolved",
+			req:           &pb.GetResourceRequest{Id: vnetID},
+			fakeCalls:     &fakeGenericCalls{},
+			providerCalls: &fakeProvidersCalls{get: []any{errors.New("error")}},
+			wantCode:      codes.Unknown,
+		},
+		{
+			name:          "Error: client returned an error",
+			req:           &pb.GetResourceRequest{Id: vnetID, ApiVersion: "2020-01-01"},
+			fakeCalls:     &fakeGenericCalls{getByID: []any{errors.New("error")}},
+			providerCalls: &fakeProvidersCalls{},
+			wantCode:      codes.Unknown,
+		},
+		{
+			name:           "Success: API version given",
+			req:            &pb.GetResourceRequest{Id: vnetID, ApiVersion: "2020-01-01"},
+			fakeCalls:      &fakeGenericCalls{getByID: []any{armresources.ClientGetByIDResponse{GenericResource: vnet}}},
+			providerCalls:  &fakeProvidersCalls{},
+			wantAPIVersion: "2020-01-01",
+			want: &pb.GetResourceReply{
+				Resource: &pb.Resource{
+					Id:         vnetID,
+					Name:       "vnet",
+					Type:       "Microsoft.Network/virtualNetworks",
+					Region:     "westus",
+					Properties: `{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}`,
+				},
+				ApiVersion: "2020-01-01",
+			},
+		},
+		{
+			name:           "Success: API version resolved",
+			req:            &pb.GetResourceRequest{Id: vnetID},
+			fakeCalls:      &fakeGenericCalls{getByID: []any{armresources.ClientGetByIDResponse{GenericResource: vnet}}},
+			providerCalls:  &fakeProvidersCalls{get: []any{networkProvider}},
+			wantAPIVersion: "2023-04-01",
+			want: &pb.GetResourceReply{
+				Resource: &pb.Resource{
+					Id:         vnetID,
+					Name:       "vnet",
+					Type:       "Microsoft.Network/virtualNetworks",
+					Region:     "westus",
+					Properties: `{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}`,
+				},
+				ApiVersion: "2023-04-01",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{
+			subscriptionID:  "subscriptionID",
+			genericClient:   mustFakeGenericClient(test.fakeCalls),
+			providersClient: mustFakeProvidersClient(test.providerCalls),
+		}
+		got, err := s.GetResource(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestGetResource(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestGetResource(%s): -want/+got:\n%s", test.name, diff)
+		}
+		if diff := cmp.Diff([]string{test.wantAPIVersion}, test.fakeCalls.gotAPIVersions); diff != "" {
+			t.Errorf("TestGetResource(%s): API versions -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestCreateOrUpdateResource(t *testing.T) {
+	t.Parallel()
+
+	created := armresources.ClientCreateOrUpdateByIDResponse{
+		GenericResource: armresources.GenericResource{ID: toPtr(vnetID), Name: toPtr("vnet"), Location: toPtr("westus")},
+	}
+
+	tests := []struct {
+		name       string
+		req        *pb.CreateOrUpdateResourceRequest
+		fakeCalls  *fakeGenericCalls
+		wantCode   codes.Code
+		wantState  pb.OperationState
+		wantParams armresources.GenericResource
+		want       *pb.Resource
+	}{
+		{
+			name:      "Error: invalid properties",
+			req:       &pb.CreateOrUpdateResourceRequest{Id: vnetID, ApiVersion: "2023-04-01", Properties: "{"},
+			fakeCalls: &fakeGenericCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: polling error",
+			req:       &pb.CreateOrUpdateResourceRequest{Id: vnetID, ApiVersion: "2023-04-01"},
+			fakeCalls: &fakeGenericCalls{beginCreateByID: []any{armresources.ClientCreateOrUpdateByIDResponse{}, errors.New("error")}},
+			wantState: pb.OperationState_OPERATION_STATE_FAILED,
+		},
+		{
+			name: "Success",
+			req: &pb.CreateOrUpdateResourceRequest{
+				Id:         vnetID,
+				ApiVersion: "2023-04-01",
+				Region:     "westus",
+				Tags:       map[string]string{"owner": "bob"},
+				Properties: `{"enableDdosProtection":false}`,
+			},
+			fakeCalls: &fakeGenericCalls{beginCreateByID: []any{armresources.ClientCreateOrUpdateByIDResponse{}, created}},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+			wantParams: armresources.GenericResource{
+				Location:   toPtr("westus"),
+				Tags:       map[string]*string{"owner": toPtr("bob")},
+				Properties: map[string]any{"enableDdosProtection": false},
+			},
+			want: &pb.Resource{Id: vnetID, Name: "vnet", Region: "westus"},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{subscriptionID: "subscriptionID", genericClient: mustFakeGenericClient(test.fakeCalls), ops: operations{pollFrequency: time.Millisecond}}
+		resp, err := s.CreateOrUpdateResource(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestCreateOrUpdateResource(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		op, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: resp.OperationId})
+		if err != nil {
+			t.Errorf("TestCreateOrUpdateResource(%s): WaitOperation() got err == %s, want err == nil", test.name, err)
+			continue
+		}
+		if op.State != test.wantState {
+			t.Errorf("TestCreateOrUpdateResource(%s): got state %v, want %v", test.name, op.State, test.wantState)
+		}
+		if op.Kind != opCreateOrUpdateResource || op.Target != vnetID {
+			t.Errorf("TestCreateOrUpdateResource(%s): got operation %s on %s, want %s on %s", test.name, op.Kind, op.Target, opCreateOrUpdateResource, vnetID)
+		}
+		if test.want == nil {
+			continue
+		}
+		if diff := cmp.Diff(test.wantParams, test.fakeCalls.gotParams[0]); diff != "" {
+			t.Errorf("TestCreateOrUpdateResource(%s): parameters -want/+got:\n%s", test.name, diff)
+		}
+		got := &pb.Resource{}
+		if err := op.Result.UnmarshalTo(got); err != nil {
+			t.Errorf("TestCreateOrUpdateResource(%s): could not unmarshal result: %s", test.name, err)
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestCreateOrUpdateResource(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestUpdateResource(t *testing.T) {
+	t.Parallel()
+
+	updated := armresources.ClientUpdateByIDResponse{GenericResource: armresources.GenericResource{ID: toPtr(vnetID)}}
+
+	tests := []struct {
+		name       string
+		req        *pb.UpdateResourceRequest
+		wantCode   codes.Code
+		wantParams armresources.GenericResource
+	}{
+		{
+			name:     "Error: empty UpdateMask",
+			req:      &pb.UpdateResourceRequest{Id: vnetID, ApiVersion: "2023-04-01"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error: field cannot be updated",
+			req:      &pb.UpdateResourceRequest{Id: vnetID, ApiVersion: "2023-04-01", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"Region"}}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:       "Success: clear tags",
+			req:        &pb.UpdateResourceRequest{Id: vnetID, ApiVersion: "2023-04-01", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"Tags"}}},
+			wantParams: armresources.GenericResource{Tags: map[string]*string{}},
+		},
+		{
+			name: "Success: properties",
+			req: &pb.UpdateResourceRequest{
+				Id:         vnetID,
+				ApiVersion: "2023-04-01",
+				Properties: `{"enableDdosProtection":true}`,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"Properties"}},
+			},
+			wantParams: armresources.GenericResource{Properties: map[string]any{"enableDdosProtection": true}},
+		},
+	}
+
+	for _, test := range tests {
+		fakeCalls := &fakeGenericCalls{beginUpdateByID: []any{armresources.ClientUpdateByIDResponse{}, updated}}
+		s := &Server{subscriptionID: "subscriptionID", genericClient: mustFakeGenericClient(fakeCalls), ops: operations{pollFrequency: time.Millisecond}}
+		resp, err := s.UpdateResource(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestUpdateResource(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		op, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: resp.OperationId})
+		if err != nil {
+			t.Errorf("TestUpdateResource(%s): WaitOperation() got err == %s, want err == nil", test.name, err)
+			continue
+		}
+		if op.State != pb.OperationState_OPERATION_STATE_SUCCEEDED {
+			t.Errorf("TestUpdateResource(%s): got state %v, want %v", test.name, op.State, pb.OperationState_OPERATION_STATE_SUCCEEDED)
+		}
+		if diff := cmp.Diff(test.wantParams, fakeCalls.gotParams[0]); diff != "" {
+			t.Errorf("TestUpdateResource(%s): parameters -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestDeleteResource(t *testing.T) {
+	t.Parallel()
+
+	fakeCalls := &fakeGenericCalls{beginDeleteByID: []any{armresources.ClientDeleteByIDResponse{}, armresources.ClientDeleteByIDResponse{}}}
+	s := &Server{
+		subscriptionID:  "subscriptionID",
+		genericClient:   mustFakeGenericClient(fakeCalls),
+		providersClient: mustFakeProvidersClient(&fakeProvidersCalls{get: []any{networkProvider}}),
+		ops:             operations{pollFrequency: time.Millisecond},
+	}
+
+	resp, err := s.DeleteResource(context.Background(), &pb.DeleteResourceRequest{Id: vnetID})
+	if err != nil {
+		t.Fatalf("TestDeleteResource: got err == %s, want err == nil", err)
+	}
+	op, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: resp.OperationId})
+	if err != nil {
+		t.Fatalf("TestDeleteResource: WaitOperation() got err == %s, want err == nil", err)
+	}
+	if op.State != pb.OperationState_OPERATION_STATE_SUCCEEDED || op.Kind != opDeleteResource || op.Target != vnetID {
+		t.Errorf("TestDeleteResource: got operation %v", op)
+	}
+	if diff := cmp.Diff([]string{"2023-04-01"}, fakeCalls.gotAPIVersions); diff != "" {
+		t.Errorf("TestDeleteResource: API versions -want/+got:\n%s", diff)
+	}
+}
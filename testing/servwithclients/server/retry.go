@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy details how calls to the greeter service are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first. Must be >= 1.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Must be > 0.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between any two attempts. Must be >= InitialBackoff.
+	MaxBackoff time.Duration
+	// Multiplier is applied to the backoff after each retry. Must be >= 1.
+	Multiplier float64
+	// Jitter randomizes each backoff by +/- this fraction of the backoff. Must be between 0 and 1.
+	Jitter float64
+	// RetriableCodes are the gRPC codes that will be retried. Must not be empty.
+	RetriableCodes []codes.Code
+	// PerAttemptTimeout, if set, bounds each attempt. An attempt that times out is retried
+	// as long as the caller's context has not expired.
+	PerAttemptTimeout time.Duration
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used if WithRetryPolicy() is not passed to New().
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetriableCodes: []codes.Code{codes.Unavailable},
+	}
+}
+
+func (r RetryPolicy) validate() error {
+	switch {
+	case r.MaxAttempts < 1:
+		return errors.New("MaxAttempts must be >= 1")
+	case r.InitialBackoff <= 0:
+		return errors.New("InitialBackoff must be > 0")
+	case r.MaxBackoff < r.InitialBackoff:
+		return errors.New("MaxBackoff must be >= InitialBackoff")
+	case r.Multiplier < 1:
+		return errors.New("Multiplier must be >= 1")
+	case r.Jitter < 0 || r.Jitter > 1:
+		return errors.New("Jitter must be between 0 and 1")
+	case len(r.RetriableCodes) == 0:
+		return errors.New("RetriableCodes must not be empty")
+	case r.PerAttemptTimeout < 0:
+		return errors.New("PerAttemptTimeout must be >= 0")
+	}
+	return nil
+}
+
+// backoff returns the delay before retry number n, where n == 0 is the first retry.
+func (r RetryPolicy) backoff(n int) time.Duration {
+	d := float64(r.InitialBackoff)
+	for i := 0; i < n; i++ {
+		d *= r.Multiplier
+		if d > float64(r.MaxBackoff) {
+			d = float64(r.MaxBackoff)
+			break
+		}
+	}
+	if r.Jitter > 0 {
+		d += d * r.Jitter * (rand.Float64()*2 - 1)
+	}
+	if d > float64(r.MaxBackoff) {
+		d = float64(r.MaxBackoff)
+	}
+	return time.Duration(d)
+}
+
+// retriable determines if err should be retried. ctx is the caller's context, which is used to
+// tell a per attempt timeout apart from the caller's deadline expiring.
+func (r RetryPolicy) retriable(ctx context.Context, err error) bool {
+	code := status.Code(err)
+	if code == codes.DeadlineExceeded && r.PerAttemptTimeout > 0 && ctx.Err() == nil {
+		return true
+	}
+	return slices.Contains(r.RetriableCodes, code)
+}
+
+// do calls f until it succeeds, returns an error that is not retriable, or the policy is exhausted.
+// It will not start an attempt if the caller's deadline would expire before the backoff and the
+// attempt could complete.
+func (r RetryPolicy) do(ctx context.Context, f func(ctx context.Context) error) error {
+	var err error
+	for i := 0; i < r.MaxAttempts; i++ {
+		if i > 0 {
+			wait := r.backoff(i - 1)
+			if !r.fits(ctx, wait) {
+				return err
+			}
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return status.FromContextError(ctx.Err()).Err()
+			case <-timer.C:
+			}
+		}
+
+		err = r.attempt(ctx, f)
+		if err == nil {
+			return nil
+		}
+		if !r.retriable(ctx, err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (r RetryPolicy) attempt(ctx context.Context, f func(ctx context.Context) error) error {
+	if r.PerAttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.PerAttemptTimeout)
+		defer cancel()
+	}
+	return f(ctx)
+}
+
+// fits reports if there is enough time left before ctx's deadline to wait and then make another attempt.
+// If PerAttemptTimeout is not set, we only require that the wait fits.
+func (r RetryPolicy) fits(ctx context.Context, wait time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return true
+	}
+	return time.Until(deadline) > wait+r.PerAttemptTimeout
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+
+	tests := []struct {
+		retry    int
+		min, max time.Duration
+	}{
+		{retry: 0, min: 50 * time.Millisecond, max: 150 * time.Millisecond},
+		{retry: 1, min: 100 * time.Millisecond, max: 300 * time.Millisecond},
+		{retry: 2, min: 200 * time.Millisecond, max: 600 * time.Millisecond},
+		{retry: 10, min: 500 * time.Millisecond, max: time.Second},
+	}
+
+	for _, test := range tests {
+		for i := 0; i < 100; i++ {
+			got := p.backoff(test.retry)
+			if got < test.min || got > test.max {
+				t.Errorf("TestRetryPolicyBackoff(retry %d): got %v, want between %v and %v", test.retry, got, test.min, test.max)
+				break
+			}
+		}
+	}
+}
+
+func TestRetryPolicyDo(t *testing.T) {
+	t.Parallel()
+
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+
+	tests := []struct {
+		name      string
+		policy    RetryPolicy
+		timeout   time.Duration
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{
+			name:      "Success after retries",
+			policy:    fastRetry,
+			errs:      []error{unavailable, unavailable, nil},
+			wantCalls: 3,
+			wantCode:  codes.OK,
+		},
+		{
+			name:      "Error: attempts exhausted",
+			policy:    fastRetry,
+			errs:      []error{unavailable, unavailable, unavailable},
+			wantCalls: 3,
+			wantCode:  codes.Unavailable,
+		},
+		{
+			name:      "Error: not retriable",
+			policy:    fastRetry,
+			errs:      []error{status.Error(codes.NotFound, "not found")},
+			wantCalls: 1,
+			wantCode:  codes.NotFound,
+		},
+		{
+			name: "Error: deadline can't fit another attempt",
+			policy: RetryPolicy{
+				MaxAttempts:    3,
+				InitialBackoff: time.Hour,
+				MaxBackoff:     time.Hour,
+				Multiplier:     1,
+				RetriableCodes: []codes.Code{codes.Unavailable},
+			},
+			timeout:   time.Minute,
+			errs:      []error{unavailable},
+			wantCalls: 1,
+			wantCode:  codes.Unavailable,
+		},
+		{
+			name: "Success: per attempt timeout is retried",
+			policy: RetryPolicy{
+				MaxAttempts:       2,
+				InitialBackoff:    time.Millisecond,
+				MaxBackoff:        time.Millisecond,
+				Multiplier:        1,
+				RetriableCodes:    []codes.Code{codes.Unavailable},
+				PerAttemptTimeout: 10 * time.Millisecond,
+			},
+			errs:      []error{status.Error(codes.DeadlineExceeded, "timeout"), nil},
+			wantCalls: 2,
+			wantCode:  codes.OK,
+		},
+	}
+
+	for _, test := range tests {
+		ctx := context.Background()
+		if test.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, test.timeout)
+			defer cancel()
+		}
+
+		calls := 0
+		err := test.policy.do(ctx, func(ctx context.Context) error {
+			defer func() { calls++ }()
+			if calls >= len(test.errs) {
+				return errors.New("unexpected call")
+			}
+			return test.errs[calls]
+		})
+		if calls != test.wantCalls {
+			t.Errorf("TestRetryPolicyDo(%s): got %d calls, want %d", test.name, calls, test.wantCalls)
+		}
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestRetryPolicyDo(%s): got code %v, want %v", test.name, status.Code(err), test.wantCode)
+		}
+	}
+}
@@ -3,11 +3,17 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	gpb "github.com/element-of-surprise/examples/testing/servwithclients/proto/greeter/proto"
 	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
@@ -19,109 +25,749 @@ type resourceClient interface {
 	CreateOrUpdate(ctx context.Context, resourceGroupName string, parameters armresources.ResourceGroup, options *armresources.ResourceGroupsClientCreateOrUpdateOptions) (armresources.ResourceGroupsClientCreateOrUpdateResponse, error)
 	BeginDelete(ctx context.Context, resourceGroupName string, options *armresources.ResourceGroupsClientBeginDeleteOptions) (*runtime.Poller[armresources.ResourceGroupsClientDeleteResponse], error)
 	Get(ctx context.Context, resourceGroupName string, options *armresources.ResourceGroupsClientGetOptions) (armresources.ResourceGroupsClientGetResponse, error)
+	BeginExportTemplate(ctx context.Context, resourceGroupName string, parameters armresources.ExportTemplateRequest, options *armresources.ResourceGroupsClientBeginExportTemplateOptions) (*runtime.Poller[armresources.ResourceGroupsClientExportTemplateResponse], error)
+	CheckExistence(ctx context.Context, resourceGroupName string, options *armresources.ResourceGroupsClientCheckExistenceOptions) (armresources.ResourceGroupsClientCheckExistenceResponse, error)
 	NewListPager(options *armresources.ResourceGroupsClientListOptions) *runtime.Pager[armresources.ResourceGroupsClientListResponse]
 	Update(ctx context.Context, resourceGroupName string, parameters armresources.ResourceGroupPatchable, options *armresources.ResourceGroupsClientUpdateOptions) (armresources.ResourceGroupsClientUpdateResponse, error)
 }
 
+// genericClient represents a client for resources of any type in the Azure Resource Manager API.
+// Usually implemented with github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources.Client{}.
+type genericClient interface {
+	GetByID(ctx context.Context, resourceID string, apiVersion string, options *armresources.ClientGetByIDOptions) (armresources.ClientGetByIDResponse, error)
+	BeginCreateOrUpdateByID(ctx context.Context, resourceID string, apiVersion string, parameters armresources.GenericResource, options *armresources.ClientBeginCreateOrUpdateByIDOptions) (*runtime.Poller[armresources.ClientCreateOrUpdateByIDResponse], error)
+	BeginUpdateByID(ctx context.Context, resourceID string, apiVersion string, parameters armresources.GenericResource, options *armresources.ClientBeginUpdateByIDOptions) (*runtime.Poller[armresources.ClientUpdateByIDResponse], error)
+	BeginDeleteByID(ctx context.Context, resourceID string, apiVersion string, options *armresources.ClientBeginDeleteByIDOptions) (*runtime.Poller[armresources.ClientDeleteByIDResponse], error)
+	NewListByResourceGroupPager(resourceGroupName string, options *armresources.ClientListByResourceGroupOptions) *runtime.Pager[armresources.ClientListByResourceGroupResponse]
+	BeginValidateMoveResources(ctx context.Context, sourceResourceGroupName string, parameters armresources.MoveInfo, options *armresources.ClientBeginValidateMoveResourcesOptions) (*runtime.Poller[armresources.ClientValidateMoveResourcesResponse], error)
+	BeginMoveResources(ctx context.Context, sourceResourceGroupName string, parameters armresources.MoveInfo, options *armresources.ClientBeginMoveResourcesOptions) (*runtime.Poller[armresources.ClientMoveResourcesResponse], error)
+}
+
+// providersClient represents a client for the resource providers in the Azure Resource Manager API.
+// Usually implemented with github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources.ProvidersClient{}.
+type providersClient interface {
+	Get(ctx context.Context, resourceProviderNamespace string, options *armresources.ProvidersClientGetOptions) (armresources.ProvidersClientGetResponse, error)
+	NewListPager(options *armresources.ProvidersClientListOptions) *runtime.Pager[armresources.ProvidersClientListResponse]
+	Register(ctx context.Context, resourceProviderNamespace string, options *armresources.ProvidersClientRegisterOptions) (armresources.ProvidersClientRegisterResponse, error)
+	Unregister(ctx context.Context, resourceProviderNamespace string, options *armresources.ProvidersClientUnregisterOptions) (armresources.ProvidersClientUnregisterResponse, error)
+}
+
+// deploymentsClient represents a client for template deployments to resource groups in the Azure Resource Manager API.
+// Usually implemented with github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources.DeploymentsClient{}.
+type deploymentsClient interface {
+	BeginCreateOrUpdate(ctx context.Context, resourceGroupName string, deploymentName string, parameters armresources.Deployment, options *armresources.DeploymentsClientBeginCreateOrUpdateOptions) (*runtime.Poller[armresources.DeploymentsClientCreateOrUpdateResponse], error)
+	BeginValidate(ctx context.Context, resourceGroupName string, deploymentName string, parameters armresources.Deployment, options *armresources.DeploymentsClientBeginValidateOptions) (*runtime.Poller[armresources.DeploymentsClientValidateResponse], error)
+	BeginWhatIf(ctx context.Context, resourceGroupName string, deploymentName string, parameters armresources.DeploymentWhatIf, options *armresources.DeploymentsClientBeginWhatIfOptions) (*runtime.Poller[armresources.DeploymentsClientWhatIfResponse], error)
+	Get(ctx context.Context, resourceGroupName string, deploymentName string, options *armresources.DeploymentsClientGetOptions) (armresources.DeploymentsClientGetResponse, error)
+	NewListByResourceGroupPager(resourceGroupName string, options *armresources.DeploymentsClientListByResourceGroupOptions) *runtime.Pager[armresources.DeploymentsClientListByResourceGroupResponse]
+}
+
+// tagsClient represents a client for the tags on any scope in the Azure Resource Manager API.
+// Usually implemented with github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources.TagsClient{}.
+type tagsClient interface {
+	GetAtScope(ctx context.Context, scope string, options *armresources.TagsClientGetAtScopeOptions) (armresources.TagsClientGetAtScopeResponse, error)
+	UpdateAtScope(ctx context.Context, scope string, parameters armresources.TagsPatchResource, options *armresources.TagsClientUpdateAtScopeOptions) (armresources.TagsClientUpdateAtScopeResponse, error)
+	NewListPager(options *armresources.TagsClientListOptions) *runtime.Pager[armresources.TagsClientListResponse]
+}
+
 // Server implements a gRPC server that acts as a proxy for the greeter service and the Azure Resource Manager.
 type Server struct {
 	pb.UnimplementedRPCServer
 
-	greeterClient  gpb.GreeterClient
-	resourceClient resourceClient
+	greeterClient     gpb.GreeterClient
+	resourceClient    resourceClient
+	genericClient     genericClient
+	providersClient   providersClient
+	deploymentsClient deploymentsClient
+	tagsClient        tagsClient
+	retryPolicy       *RetryPolicy
+	pageTokenKey      []byte
+	ops               operations
+	apiVersions       apiVersionCache
+	allowedRegions    map[string]struct{}
+	// subscriptionID is the subscription resourceClient manages. It is needed to accept resource group IDs.
+	subscriptionID string
+	// clients holds the clients for subscriptions other than subscriptionID. If nil, only subscriptionID is managed.
+	clients        *clientPool[*subscriptionClients]
+	clientPoolSize int
+	// rgCache caches resource group reads and lists. If nil, nothing is cached.
+	rgCache *rgCache
+}
+
+// Option is an optional argument to New().
+type Option func(s *Server) error
+
+// WithRetryPolicy sets the RetryPolicy used when calling the greeter service.
+// If not provided, DefaultRetryPolicy() is used.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(s *Server) error {
+		if err := p.validate(); err != nil {
+			return fmt.Errorf("invalid RetryPolicy: %w", err)
+		}
+		p.RetriableCodes = slices.Clone(p.RetriableCodes)
+		s.retryPolicy = &p
+		return nil
+	}
+}
+
+// WithPageTokenKey sets the key used to sign page tokens. Servers that share a key accept each
+// other's page tokens. If not provided, a random key is used and tokens are only valid until the process exits.
+func WithPageTokenKey(key []byte) Option {
+	return func(s *Server) error {
+		if len(key) < 32 {
+			return errors.New("page token key must be at least 32 bytes")
+		}
+		s.pageTokenKey = slices.Clone(key)
+		return nil
+	}
+}
+
+// WithPollFrequency sets how often ARM is polled for the status of long-running operations. A poll that fails
+// with a transient error is retried, backing off from d. If not provided, operations are polled every 10 seconds.
+func WithPollFrequency(d time.Duration) Option {
+	return func(s *Server) error {
+		if d <= 0 {
+			return errors.New("poll frequency must be > 0")
+		}
+		s.ops.pollFrequency = d
+		return nil
+	}
+}
+
+// WithOperationDir persists long-running operations to files in dir. When the Server is created, operations
+// found in dir are loaded and those that were still running are resumed, so that operations survive restarts.
+// dir should not be shared by servers that are running at the same time.
+func WithOperationDir(dir string) Option {
+	return func(s *Server) error {
+		store, err := newFileStore(dir)
+		if err != nil {
+			return err
+		}
+		s.ops.store = store
+		return nil
+	}
+}
+
+// WithAllowedRegions restricts the regions resource groups can be created in. Regions can be given
+// by name ("westus2") or display name ("West US 2"). If not provided, any region is allowed.
+func WithAllowedRegions(regions ...string) Option {
+	return func(s *Server) error {
+		s.allowedRegions = make(map[string]struct{}, len(regions))
+		for _, r := range regions {
+			if r == "" {
+				return errors.New("allowed regions cannot contain an empty region")
+			}
+			s.allowedRegions[normalizeRegion(r)] = struct{}{}
+		}
+		return nil
+	}
+}
+
+// WithSubscriptionID sets the subscription the resource client passed to New() manages. If not provided,
+// resource groups can only be referred to by name, not by ARM ID.
+func WithSubscriptionID(id string) Option {
+	return func(s *Server) error {
+		if id == "" {
+			return errors.New("subscription ID cannot be empty")
+		}
+		s.subscriptionID = id
+		return nil
+	}
+}
+
+// WithClientFactory provides the clients for the subscription managed by the resource client passed to New().
+// The RPCs that act on resources inside resource groups are Unimplemented without it.
+func WithClientFactory(f *armresources.ClientFactory) Option {
+	return func(s *Server) error {
+		if f == nil {
+			return errors.New("WithClientFactory: factory cannot be nil")
+		}
+		c := newSubscriptionClients(f)
+		s.genericClient = c.resources
+		s.providersClient = c.providers
+		s.deploymentsClient = c.deployments
+		s.tagsClient = c.tags
+		return nil
+	}
+}
+
+// WithSubscriptions allows RPCs to act on subscriptions other than the one managed by the resource client
+// passed to New(). Clients for the allowed subscriptions are created with newClient the first time they are
+// needed. Requests for subscriptions that are not allowed fail with PermissionDenied.
+// Only the resource group RPCs are available for these subscriptions, use WithSubscriptionFactories() for the others.
+func WithSubscriptions(newClient func(subscription string) (*armresources.ResourceGroupsClient, error), allowed ...string) Option {
+	return func(s *Server) error {
+		if newClient == nil {
+			return errors.New("WithSubscriptions: newClient is required")
+		}
+		newClients := func(sub string) (*subscriptionClients, error) {
+			c, err := newClient(sub)
+			if err != nil {
+				return nil, err
+			}
+			return &subscriptionClients{groups: c}, nil
+		}
+		return s.setSubscriptions("WithSubscriptions", newClients, allowed)
+	}
+}
+
+// WithSubscriptionFactories is like WithSubscriptions(), but the clients for the allowed subscriptions are
+// created from the factory returned by newFactory, so that all RPCs are available for them.
+func WithSubscriptionFactories(newFactory func(subscription string) (*armresources.ClientFactory, error), allowed ...string) Option {
+	return func(s *Server) error {
+		if newFactory == nil {
+			return errors.New("WithSubscriptionFactories: newFactory is required")
+		}
+		newClients := func(sub string) (*subscriptionClients, error) {
+			f, err := newFactory(sub)
+			if err != nil {
+				return nil, err
+			}
+			return newSubscriptionClients(f), nil
+		}
+		return s.setSubscriptions("WithSubscriptionFactories", newClients, allowed)
+	}
+}
+
+// setSubscriptions sets the client pool for the allowed subscriptions. option is the name of the option doing so.
+func (s *Server) setSubscriptions(option string, newClients func(sub string) (*subscriptionClients, error), allowed []string) error {
+	if s.clients != nil {
+		return fmt.Errorf("%s: only one of WithSubscriptions() and WithSubscriptionFactories() can be used", option)
+	}
+	pool, err := newClientPool(newClients, allowed)
+	if err != nil {
+		return fmt.Errorf("%s: %w", option, err)
+	}
+	s.clients = pool
+	return nil
+}
+
+// WithClientPoolSize sets the maximum number of subscription clients created by WithSubscriptions() or
+// WithSubscriptionFactories() that are kept. When more are needed, the least recently used is discarded. If not provided, 16 are kept.
+func WithClientPoolSize(n int) Option {
+	return func(s *Server) error {
+		if n < 1 {
+			return errors.New("client pool size must be >= 1")
+		}
+		s.clientPoolSize = n
+		return nil
+	}
+}
+
+// WithResourceGroupCache caches the resource groups returned by ReadResourceGroup() and the pages returned by
+// ListResourceGroups() for up to ttl, keeping at most maxEntries. When more are needed, expired entries are
+// discarded, then the least recently used. CreateResourceGroup(), UpdateResourceGroup() and DeleteResourceGroup()
+// remove the group they change and every cached page for its subscription. Changes made outside the server are
+// not seen until entries expire; requests can set BypassCache to read from ARM. If not provided, nothing is cached.
+func WithResourceGroupCache(ttl time.Duration, maxEntries int) Option {
+	return func(s *Server) error {
+		if ttl <= 0 {
+			return errors.New("resource group cache TTL must be > 0")
+		}
+		if maxEntries < 1 {
+			return errors.New("resource group cache max entries must be >= 1")
+		}
+		s.rgCache = newRGCache(ttl, maxEntries)
+		return nil
+	}
 }
 
 // New is the constructore for Server.
-func New(greeter gpb.GreeterClient, resources resourceClient) (*Server, error) {
+func New(greeter gpb.GreeterClient, resources resourceClient, options ...Option) (*Server, error) {
 	if greeter == nil {
 		return nil, errors.New("greeter is required")
 	}
 	if resources == nil {
 		return nil, errors.New("resources is required")
 	}
-	return &Server{
+	s := &Server{
 		greeterClient:  greeter,
 		resourceClient: resources,
-	}, nil
+	}
+	for _, o := range options {
+		if err := o(s); err != nil {
+			return nil, err
+		}
+	}
+	if s.clientPoolSize > 0 {
+		if s.clients == nil {
+			return nil, errors.New("WithClientPoolSize() requires WithSubscriptions() or WithSubscriptionFactories()")
+		}
+		s.clients.size = s.clientPoolSize
+	}
+	if s.ops.store != nil {
+		if err := s.ops.recover(s.resumePoller); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// retry returns the RetryPolicy for calls to the greeter service.
+func (s *Server) retry() RetryPolicy {
+	if s.retryPolicy == nil {
+		return DefaultRetryPolicy()
+	}
+	return *s.retryPolicy
 }
 
 // SayHello implements gpb.GreeterClient.SayHello().
 func (s *Server) SayHello(ctx context.Context, in *gpb.HelloRequest) (*gpb.HelloReply, error) {
-	const maxTries = 3
-
 	var resp *gpb.HelloReply
-	var err error
-	for i := 0; i < maxTries; i++ {
+	err := s.retry().do(ctx, func(ctx context.Context) error {
+		var err error
 		resp, err = s.greeterClient.SayHello(ctx, in)
-		if err == nil {
-			return resp, nil
-		}
-
-		switch status.Code(err) {
-		case codes.Unavailable:
-			continue
-		default:
-			return nil, err
-		}
+		return err
+	})
+	if err != nil {
+		return nil, azError(err)
 	}
-	return nil, err
+	return resp, nil
 }
 
 func (s *Server) CreateResourceGroup(ctx context.Context, in *pb.CreateResourceGroupRequest) (*pb.CreateResourceGroupReply, error) {
-	_, err := s.resourceClient.CreateOrUpdate(ctx, in.GetName(), armresources.ResourceGroup{Location: &in.Region}, nil)
+	v := violations{}
+	validateRGName(&v, "Name", in.GetName())
+	s.validateRegion(&v, "Region", in.GetRegion())
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	client, err := s.clientFor(in.GetSubscription())
 	if err != nil {
 		return nil, err
 	}
 
+	params := armresources.ResourceGroup{Location: &in.Region, Tags: toAzTags(in.GetTags())}
+	_, err = client.CreateOrUpdate(ctx, in.GetName(), params, nil)
+	// The group may have changed even if an error was returned.
+	s.invalidateGroup(in.GetSubscription(), in.GetName())
+	if err != nil {
+		return nil, azError(err)
+	}
+
 	return &pb.CreateResourceGroupReply{Status: "Success"}, nil
 }
 
 func (s *Server) ReadResourceGroup(ctx context.Context, in *pb.ReadResourceGroupRequest) (*pb.ReadResourceGroupReply, error) {
-	_, err := s.resourceClient.Get(ctx, in.GetId(), nil)
+	client, rg, err := s.resourceGroup("Id", in.GetId(), in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+
+	sub := s.cacheSubscription(rg.subscription)
+	if !in.GetBypassCache() {
+		if group, ok := s.rgCache.getGroup(sub, rg.name); ok {
+			return &pb.ReadResourceGroupReply{Status: "Success", ResourceGroup: group}, nil
+		}
+	}
+
+	resp, err := client.Get(ctx, rg.name, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+
+	group := toPBResourceGroup(resp.ResourceGroup)
+	s.rgCache.putGroup(sub, rg.name, group)
+	return &pb.ReadResourceGroupReply{Status: "Success", ResourceGroup: group}, nil
+}
+
+// ResourceGroupExists reports if a resource group exists. ARM answers with only a status code, so
+// this is cheaper than ReadResourceGroup() and absence is not an error.
+func (s *Server) ResourceGroupExists(ctx context.Context, in *pb.ResourceGroupExistsRequest) (*pb.ResourceGroupExistsReply, error) {
+	client, rg, err := s.resourceGroup("Id", in.GetId(), in.GetSubscription())
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ReadResourceGroupReply{Status: "Success"}, nil
+	resp, err := client.CheckExistence(ctx, rg.name, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	return &pb.ResourceGroupExistsReply{Exists: resp.Success}, nil
 }
 
+// UpdateResourceGroup patches the fields of a resource group named in the UpdateMask and returns the updated group.
+// ARM replaces all tags on a resource group when they are patched, so TAG_OPERATION_MERGE and TAG_OPERATION_DELETE
+// read the current tags and send the result. There is no concurrency check between the read and the write: tags
+// another client writes in between are lost.
 func (s *Server) UpdateResourceGroup(ctx context.Context, in *pb.UpdateResourceGroupRequest) (*pb.UpdateResourceGroupReply, error) {
-	_, err := s.resourceClient.Update(ctx, in.GetName(), armresources.ResourceGroupPatchable{ManagedBy: &in.Id}, nil)
+	v := violations{}
+	validateRGName(&v, "Name", in.GetName())
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	client, err := s.clientFor(in.GetSubscription())
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.UpdateResourceGroupReply{Status: "Success"}, nil
+	fields, err := updateFields(in.GetUpdateMask())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if fields.tags && in.GetTagOperation() == pb.TagOperation_TAG_OPERATION_UNSPECIFIED {
+		v.add("TagOperation", "is required when UpdateMask names Tags")
+		return nil, v.err()
+	}
+
+	patch := armresources.ResourceGroupPatchable{}
+	if fields.managedBy {
+		managedBy := in.GetManagedBy()
+		patch.ManagedBy = &managedBy
+	}
+	if fields.tags {
+		var current map[string]*string
+		if needsCurrentTags(in.GetTagOperation()) {
+			resp, err := client.Get(ctx, in.GetName(), nil)
+			if err != nil {
+				return nil, azError(err)
+			}
+			current = resp.Tags
+		}
+		tags, err := applyTags(current, in.GetTagOperation(), in.GetTags())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		patch.Tags = tags
+	}
+
+	resp, err := client.Update(ctx, in.GetName(), patch, nil)
+	s.invalidateGroup(in.GetSubscription(), in.GetName())
+	if err != nil {
+		return nil, azError(err)
+	}
+
+	return &pb.UpdateResourceGroupReply{Status: "Success", ResourceGroup: toPBResourceGroup(resp.ResourceGroup)}, nil
+}
+
+// patchFields are the fields named in an UpdateResourceGroupRequest.UpdateMask.
+type patchFields struct {
+	tags      bool
+	managedBy bool
+}
+
+// updateFields validates the UpdateMask of an UpdateResourceGroupRequest and returns the fields to patch.
+func updateFields(mask *fieldmaskpb.FieldMask) (patchFields, error) {
+	pf := patchFields{}
+
+	if len(mask.GetPaths()) == 0 {
+		return pf, errors.New("UpdateMask must name at least one field")
+	}
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "Tags":
+			pf.tags = true
+		case "ManagedBy":
+			pf.managedBy = true
+		case "Id", "Name", "Region":
+			return pf, fmt.Errorf("UpdateMask: field %q is immutable", path)
+		default:
+			return pf, fmt.Errorf("UpdateMask: field %q cannot be updated", path)
+		}
+	}
+	return pf, nil
 }
 
+// DeleteResourceGroup starts deleting a resource group and returns the ID of the long-running operation
+// doing the delete. Use GetOperation() or WaitOperation() to find out when the delete completes.
 func (s *Server) DeleteResourceGroup(ctx context.Context, in *pb.DeleteResourceGroupRequest) (*pb.DeleteResourceGroupReply, error) {
-	poll, err := s.resourceClient.BeginDelete(ctx, in.GetId(), nil)
+	client, rg, err := s.resourceGroup("Id", in.GetId(), in.GetSubscription())
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = poll.PollUntilDone(ctx, nil)
+	poll, err := client.BeginDelete(ctx, rg.name, nil)
 	if err != nil {
-		return nil, err
+		return nil, azError(err)
 	}
 
-	return &pb.DeleteResourceGroupReply{Status: "Success"}, nil
+	op := s.ops.start(opDeleteResourceGroup, rg.id(), armPoller[armresources.ResourceGroupsClientDeleteResponse]{Poller: poll})
+	s.invalidateGroup(rg.subscription, rg.name)
+	// Reads made while the delete is running cache the group as it was, so drop it again when it is done.
+	op.onFinish(func() { s.invalidateGroup(rg.subscription, rg.name) })
+
+	return &pb.DeleteResourceGroupReply{Status: "Running", OperationId: op.proto().Id}, nil
 }
 
 func (s *Server) ListResourceGroups(ctx context.Context, in *pb.ListResourceGroupsRequest) (*pb.ListResourceGroupsReply, error) {
-	pager := s.resourceClient.NewListPager(nil)
-	groups := []*pb.ResourceGroup{}
+	size, err := pageSize(in.GetPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter, err := newListFilter(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// The server's subscription can be given or left empty, so a page token works for both.
+	filter.subscription = strings.ToLower(s.cacheSubscription(in.GetSubscription()))
+	tok, err := s.requestPageToken(in.GetPageToken(), filter.hash())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.clientFor(in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+
+	key := listKey(s.cacheSubscription(in.GetSubscription()), filter.hash(), size, in.GetPageToken())
+	if !in.GetBypassCache() {
+		if reply, ok := s.rgCache.getList(key); ok {
+			return reply, nil
+		}
+	}
+	reply, err := s.listResourceGroups(ctx, client, filter, tok, size)
+	if err != nil {
+		return nil, err
+	}
+	s.rgCache.putList(key, reply)
+	return reply, nil
+}
+
+// listResourceGroups reads a page of up to size resource groups that pass filter from ARM, starting at tok.
+func (s *Server) listResourceGroups(ctx context.Context, client resourceClient, filter listFilter, tok pageToken, size int) (*pb.ListResourceGroupsReply, error) {
+	page := func(p armresources.ResourceGroupsClientListResponse) ([]*armresources.ResourceGroup, *string) {
+		var groups []*armresources.ResourceGroup
+		for _, group := range p.Value {
+			if group != nil && group.Name != nil && filter.match(group) {
+				groups = append(groups, group)
+			}
+		}
+		return groups, p.NextLink
+	}
+	items, next, err := readPage(ctx, client.NewListPager(filter.options()), tok, size, page)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListResourceGroupsReply{ResourceGroups: make([]*pb.ResourceGroup, 0, len(items))}
+	for _, group := range items {
+		reply.ResourceGroups = append(reply.ResourceGroups, toPBResourceGroup(*group))
+	}
+	if reply.NextPageToken, err = s.nextPageToken(next); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// StreamResourceGroups sends each resource group that passes the filters in the request.
+// Only one ARM page is held at a time; the next page is not fetched until every group in
+// the current page has been accepted by the stream's flow control.
+func (s *Server) StreamResourceGroups(in *pb.StreamResourceGroupsRequest, stream pb.RPC_StreamResourceGroupsServer) error {
+	filter, err := newListFilter(in)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	client, err := s.clientFor(in.GetSubscription())
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	pager := client.NewListPager(filter.options())
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
-			return nil, err
+			return azError(err)
 		}
 		for _, group := range page.Value {
-			if group.Name == nil {
+			if group == nil || group.Name == nil || !filter.match(group) {
 				continue
 			}
-			groups = append(groups, &pb.ResourceGroup{Name: *group.Name})
+			if err := stream.Send(toPBResourceGroup(*group)); err != nil {
+				return err
+			}
 		}
 	}
-	return &pb.ListResourceGroupsReply{ResourceGroups: groups}, nil
+	return nil
+}
+
+// DeleteResourceGroupWatch starts deleting a resource group and sends an event each time the delete is polled,
+// until it is done. Polls made while an event is being sent are sent after it, so every poll has an event.
+func (s *Server) DeleteResourceGroupWatch(in *pb.DeleteResourceGroupRequest, stream pb.RPC_DeleteResourceGroupWatchServer) error {
+	ctx := stream.Context()
+
+	client, rg, err := s.resourceGroup("Id", in.GetId(), in.GetSubscription())
+	if err != nil {
+		return err
+	}
+
+	poll, err := client.BeginDelete(ctx, rg.name, nil)
+	if err != nil {
+		return azError(err)
+	}
+	op := s.ops.start(opDeleteResourceGroup, rg.id(), armPoller[armresources.ResourceGroupsClientDeleteResponse]{Poller: poll})
+	s.invalidateGroup(rg.subscription, rg.name)
+	// Reads made while the delete is running cache the group as it was, so drop it again when it is done.
+	op.onFinish(func() { s.invalidateGroup(rg.subscription, rg.name) })
+
+	// sent is the Poll of the last event sent, or -1 before the event for the start of the delete.
+	sent := int32(-1)
+	for {
+		changed := op.changes()
+		p, polls := op.pollsSince(sent)
+		for _, ev := range deleteEvents(p, sent, polls) {
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+			sent = ev.Poll
+		}
+		if p.State != pb.OperationState_OPERATION_STATE_RUNNING {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-changed:
+		}
+	}
+}
+
+// deleteEvents converts polls, the polls of the delete operation op after poll sent, to one DeleteResourceGroupEvent
+// each. If sent is -1, the event for the start of the delete comes first. If op is done, the last event has its
+// final state, and is added for the last poll if there are no new polls.
+func deleteEvents(op *pb.Operation, sent int32, polls []pollRecord) []*pb.DeleteResourceGroupEvent {
+	start := op.CreateTime.AsTime()
+	var events []*pb.DeleteResourceGroupEvent
+	if sent < 0 {
+		events = append(events, &pb.DeleteResourceGroupEvent{
+			OperationId: op.Id,
+			State:       pb.OperationState_OPERATION_STATE_RUNNING,
+			Elapsed:     durationpb.New(0),
+		})
+	}
+	for _, r := range polls {
+		events = append(events, &pb.DeleteResourceGroupEvent{
+			OperationId: op.Id,
+			State:       pb.OperationState_OPERATION_STATE_RUNNING,
+			Poll:        r.n,
+			HTTPStatus:  r.httpStatus,
+			Elapsed:     durationpb.New(r.at.Sub(start)),
+		})
+	}
+	if op.State == pb.OperationState_OPERATION_STATE_RUNNING {
+		return events
+	}
+
+	if len(events) == 0 {
+		events = append(events, &pb.DeleteResourceGroupEvent{
+			OperationId: op.Id,
+			Poll:        op.Polls,
+			HTTPStatus:  op.LastHTTPStatus,
+			Elapsed:     durationpb.New(time.Since(start)),
+		})
+	}
+	last := events[len(events)-1]
+	last.State = op.State
+	last.ErrorCode = op.ErrorCode
+	last.ErrorMessage = op.ErrorMessage
+	return events
+}
+
+// Kinds of long-running operations.
+const (
+	opDeleteResourceGroup         = "DeleteResourceGroup"
+	opExportResourceGroupTemplate = "ExportResourceGroupTemplate"
+	opCreateOrUpdateResource      = "CreateOrUpdateResource"
+	opUpdateResource              = "UpdateResource"
+	opDeleteResource              = "DeleteResource"
+	opDeployTemplate              = "DeployTemplate"
+	opValidateDeployment          = "ValidateDeployment"
+	opWhatIfDeployment            = "WhatIfDeployment"
+	opValidateMoveResources       = "ValidateMoveResources"
+	opMoveResources               = "MoveResources"
+)
+
+// resumePoller recreates the poller for an operation of kind acting on target from its resume token.
+func (s *Server) resumePoller(kind, target, token string) (lroPoller, error) {
+	if token == "" {
+		return nil, errors.New("operation has no resume token")
+	}
+
+	switch kind {
+	case opDeleteResourceGroup:
+		client, rg, err := s.resourceGroup("Target", target, "")
+		if err != nil {
+			return nil, err
+		}
+		// When given a ResumeToken, BeginDelete() doesn't send a request. It recreates the poller
+		// with runtime.NewPollerFromResumeToken().
+		poll, err := client.BeginDelete(context.Background(), rg.name, &armresources.ResourceGroupsClientBeginDeleteOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return armPoller[armresources.ResourceGroupsClientDeleteResponse]{Poller: poll}, nil
+	case opExportResourceGroupTemplate:
+		client, rg, err := s.resourceGroup("Target", target, "")
+		if err != nil {
+			return nil, err
+		}
+		poll, err := client.BeginExportTemplate(context.Background(), rg.name, armresources.ExportTemplateRequest{}, &armresources.ResourceGroupsClientBeginExportTemplateOptions{ResumeToken: token})
+		if err != nil {
+			return nil, err
+		}
+		return exportPoller(poll), nil
+	case opCreateOrUpdateResource, opUpdateResource, opDeleteResource:
+		return s.resumeResourcePoller(kind, target, token)
+	case opDeployTemplate, opValidateDeployment, opWhatIfDeployment:
+		return s.resumeDeploymentPoller(kind, target, token)
+	case opValidateMoveResources, opMoveResources:
+		return s.resumeMovePoller(kind, target, token)
+	}
+	return nil, fmt.Errorf("unknown operation kind %q", kind)
+}
+
+// GetOperation implements pb.RPCServer.GetOperation().
+func (s *Server) GetOperation(ctx context.Context, in *pb.GetOperationRequest) (*pb.Operation, error) {
+	op, ok := s.ops.get(in.GetId())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "operation %q not found", in.GetId())
+	}
+	return op.proto(), nil
+}
+
+// WaitOperation implements pb.RPCServer.WaitOperation(). If the timeout expires before the operation
+// is done, the operation is returned in its current state.
+func (s *Server) WaitOperation(ctx context.Context, in *pb.WaitOperationRequest) (*pb.Operation, error) {
+	op, ok := s.ops.get(in.GetId())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "operation %q not found", in.GetId())
+	}
+
+	var timeout <-chan time.Time
+	if in.GetTimeout() != nil {
+		if err := in.GetTimeout().CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		timer := time.NewTimer(in.GetTimeout().AsDuration())
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-timeout:
+	case <-op.done:
+	}
+	return op.proto(), nil
+}
+
+// ListOperations implements pb.RPCServer.ListOperations().
+func (s *Server) ListOperations(ctx context.Context, in *pb.ListOperationsRequest) (*pb.ListOperationsReply, error) {
+	return &pb.ListOperationsReply{Operations: s.ops.list(in.GetKind())}, nil
+}
+
+// CancelOperation implements pb.RPCServer.CancelOperation().
+func (s *Server) CancelOperation(ctx context.Context, in *pb.CancelOperationRequest) (*pb.Operation, error) {
+	op, err := s.ops.cancel(in.GetId())
+	switch {
+	case errors.Is(err, errOperationNotFound):
+		return nil, status.Errorf(codes.NotFound, "operation %q not found", in.GetId())
+	case errors.Is(err, errOperationDone):
+		return nil, status.Errorf(codes.FailedPrecondition, "operation %q is already done", in.GetId())
+	}
+	return op.proto(), nil
 }
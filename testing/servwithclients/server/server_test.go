@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -12,14 +14,25 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources/fake"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	gpb "github.com/element-of-surprise/examples/testing/servwithclients/proto/greeter/proto"
 	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
 )
 
+// fastRetry is a RetryPolicy that doesn't slow down tests.
+var fastRetry = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond,
+	Multiplier:     1,
+	RetriableCodes: []codes.Code{codes.Unavailable},
+}
+
 func TestSayHello(t *testing.T) {
 	t.Parallel()
 
@@ -71,7 +84,7 @@ func TestSayHello(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		s := &Server{greeterClient: test.greeter}
+		s := &Server{greeterClient: test.greeter, retryPolicy: &fastRetry}
 		got, err := s.SayHello(context.Background(), test.req)
 		switch {
 		case err == nil && test.wantErr:
@@ -109,19 +122,63 @@ func mustFakeResourceGroupClient(calls *fakeResourceCalls) resourceClient {
 func TestCreateResourceGroup(t *testing.T) {
 	t.Parallel()
 
+	req := &pb.CreateResourceGroupRequest{Name: "name", Region: "westus"}
+
 	tests := []struct {
-		name      string
-		fakeCalls *fakeResourceCalls
-		client    resourceClient
-		wantErr   bool
+		name           string
+		req            *pb.CreateResourceGroupRequest
+		allowedRegions []string
+		fakeCalls      *fakeResourceCalls
+		client         resourceClient
+		wantErr        bool
+		wantViolations []string
 	}{
+		{
+			name:           "Error: empty name and region",
+			req:            &pb.CreateResourceGroupRequest{},
+			fakeCalls:      &fakeResourceCalls{},
+			wantErr:        true,
+			wantViolations: []string{"Name", "Region"},
+		},
+		{
+			name:           "Error: name too long",
+			req:            &pb.CreateResourceGroupRequest{Name: strings.Repeat("a", 91), Region: "westus"},
+			fakeCalls:      &fakeResourceCalls{},
+			wantErr:        true,
+			wantViolations: []string{"Name"},
+		},
+		{
+			name:           "Error: illegal character",
+			req:            &pb.CreateResourceGroupRequest{Name: "my/group", Region: "westus"},
+			fakeCalls:      &fakeResourceCalls{},
+			wantErr:        true,
+			wantViolations: []string{"Name"},
+		},
+		{
+			name:           "Error: trailing period",
+			req:            &pb.CreateResourceGroupRequest{Name: "group.", Region: "westus"},
+			fakeCalls:      &fakeResourceCalls{},
+			wantErr:        true,
+			wantViolations: []string{"Name"},
+		},
+		{
+			name:           "Error: region not allowed",
+			req:            req,
+			allowedRegions: []string{"East US"},
+			fakeCalls:      &fakeResourceCalls{},
+			wantErr:        true,
+			wantViolations: []string{"Region"},
+		},
 		{
 			name:      "Error: client returned an error",
+			req:       req,
 			fakeCalls: &fakeResourceCalls{createOrUpdate: []any{errors.New("error")}},
 			wantErr:   true,
 		},
 		{
-			name: "Success",
+			name:           "Success",
+			req:            &pb.CreateResourceGroupRequest{Name: "my_group-(ünïcode).1", Region: "West US"},
+			allowedRegions: []string{"westus"},
 			fakeCalls: &fakeResourceCalls{
 				createOrUpdate: []any{
 					armresources.ResourceGroupsClientCreateOrUpdateResponse{
@@ -135,7 +192,12 @@ func TestCreateResourceGroup(t *testing.T) {
 	for _, test := range tests {
 		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
 		s := &Server{resourceClient: fakeClient}
-		_, err := s.CreateResourceGroup(context.Background(), &pb.CreateResourceGroupRequest{Name: "name"})
+		if test.allowedRegions != nil {
+			if err := WithAllowedRegions(test.allowedRegions...)(s); err != nil {
+				t.Fatal(err)
+			}
+		}
+		_, err := s.CreateResourceGroup(context.Background(), test.req)
 		switch {
 		case err == nil && test.wantErr:
 			t.Errorf("TestCreateResourceGroup(%s): got err == nil, want err != nil", test.name)
@@ -144,6 +206,18 @@ func TestCreateResourceGroup(t *testing.T) {
 			t.Errorf("TestCreateResourceGroup(%s): got err == %s, want err == nil", test.name, err)
 			continue
 		}
+
+		var gotViolations []string
+		for _, d := range status.Convert(err).Details() {
+			if br, ok := d.(*errdetails.BadRequest); ok {
+				for _, fv := range br.FieldViolations {
+					gotViolations = append(gotViolations, fv.Field)
+				}
+			}
+		}
+		if diff := cmp.Diff(test.wantViolations, gotViolations); diff != "" {
+			t.Errorf("TestCreateResourceGroup(%s): field violations -want/+got:\n%s", test.name, diff)
+		}
 	}
 }
 
@@ -155,6 +229,7 @@ func TestDeleteResourceGroup(t *testing.T) {
 		fakeCalls *fakeResourceCalls
 		client    resourceClient
 		wantErr   bool
+		wantState pb.OperationState
 	}{
 		{
 			name:      "Error: client returned an error",
@@ -169,7 +244,7 @@ func TestDeleteResourceGroup(t *testing.T) {
 					fmt.Errorf("error"),
 				},
 			},
-			wantErr: true,
+			wantState: pb.OperationState_OPERATION_STATE_FAILED,
 		},
 		{
 			name: "Success",
@@ -179,21 +254,680 @@ func TestDeleteResourceGroup(t *testing.T) {
 					armresources.ResourceGroupsClientDeleteResponse{},
 				},
 			},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient, ops: operations{pollFrequency: time.Millisecond}}
+		resp, err := s.DeleteResourceGroup(context.Background(), &pb.DeleteResourceGroupRequest{Id: "id"})
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestDeleteResourceGroup(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestDeleteResourceGroup(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+
+		op, err := s.WaitOperation(context.Background(), &pb.WaitOperationRequest{Id: resp.OperationId})
+		if err != nil {
+			t.Errorf("TestDeleteResourceGroup(%s): WaitOperation() got err == %s, want err == nil", test.name, err)
+			continue
+		}
+		if op.State != test.wantState {
+			t.Errorf("TestDeleteResourceGroup(%s): got state %v, want %v", test.name, op.State, test.wantState)
+		}
+		if op.Kind != "DeleteResourceGroup" || op.Target != "id" {
+			t.Errorf("TestDeleteResourceGroup(%s): got Kind %q Target %q, want DeleteResourceGroup id", test.name, op.Kind, op.Target)
+		}
+	}
+}
+
+func TestDeleteResourceGroupWatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeResourceCalls
+		sendDelay time.Duration
+		wantErr   bool
+		wantState pb.OperationState
+	}{
+		{
+			name:      "Error: client returned an error",
+			fakeCalls: &fakeResourceCalls{beginDeleteErr: errors.New("error")},
+			wantErr:   true,
+		},
+		{
+			name: "Error: polling error",
+			fakeCalls: &fakeResourceCalls{
+				beginDelete: []any{
+					armresources.ResourceGroupsClientDeleteResponse{},
+					fmt.Errorf("error"),
+				},
+			},
+			wantState: pb.OperationState_OPERATION_STATE_FAILED,
+		},
+		{
+			name: "Success",
+			fakeCalls: &fakeResourceCalls{
+				beginDelete: []any{
+					armresources.ResourceGroupsClientDeleteResponse{},
+					armresources.ResourceGroupsClientDeleteResponse{},
+					armresources.ResourceGroupsClientDeleteResponse{},
+				},
+			},
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+		},
+		{
+			name: "Success: polls while sending",
+			fakeCalls: &fakeResourceCalls{
+				beginDelete: []any{
+					armresources.ResourceGroupsClientDeleteResponse{},
+					armresources.ResourceGroupsClientDeleteResponse{},
+					armresources.ResourceGroupsClientDeleteResponse{},
+					armresources.ResourceGroupsClientDeleteResponse{},
+					armresources.ResourceGroupsClientDeleteResponse{},
+				},
+			},
+			sendDelay: 10 * time.Millisecond,
+			wantState: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient, ops: operations{pollFrequency: time.Millisecond}}
+		stream := &fakeServerStream[*pb.DeleteResourceGroupEvent]{sendDelay: test.sendDelay}
+		err := s.DeleteResourceGroupWatch(&pb.DeleteResourceGroupRequest{Id: "id"}, stream)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestDeleteResourceGroupWatch(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestDeleteResourceGroupWatch(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+
+		if len(stream.sent) == 0 {
+			t.Errorf("TestDeleteResourceGroupWatch(%s): got no events", test.name)
+			continue
+		}
+		for i, ev := range stream.sent {
+			if ev.OperationId != stream.sent[0].OperationId {
+				t.Errorf("TestDeleteResourceGroupWatch(%s): event %d has OperationId %q, want %q", test.name, i, ev.OperationId, stream.sent[0].OperationId)
+			}
+			if ev.Poll != int32(i) {
+				t.Errorf("TestDeleteResourceGroupWatch(%s): event %d has Poll %d, want %d", test.name, i, ev.Poll, i)
+			}
+		}
+		last := stream.sent[len(stream.sent)-1]
+		if last.State != test.wantState {
+			t.Errorf("TestDeleteResourceGroupWatch(%s): got final state %v, want %v", test.name, last.State, test.wantState)
+		}
+		op, _ := s.ops.get(last.OperationId)
+		if polls := op.proto().Polls; last.Poll != polls {
+			t.Errorf("TestDeleteResourceGroupWatch(%s): got last event for poll %d, want %d (every poll)", test.name, last.Poll, polls)
+		}
+	}
+}
+
+func TestReadResourceGroup(t *testing.T) {
+	t.Parallel()
+
+	group := armresources.ResourceGroup{
+		ID:         toPtr("/subscriptions/subscriptionID/resourceGroups/name"),
+		Name:       toPtr("name"),
+		Location:   toPtr("westus"),
+		ManagedBy:  toPtr("manager"),
+		Tags:       map[string]*string{"owner": toPtr("bob")},
+		Properties: &armresources.ResourceGroupProperties{ProvisioningState: toPtr("Succeeded")},
+	}
+
+	want := &pb.ReadResourceGroupReply{
+		Status: "Success",
+		ResourceGroup: &pb.ResourceGroup{
+			Id:                "/subscriptions/subscriptionID/resourceGroups/name",
+			Name:              "name",
+			Region:            "westus",
+			ManagedBy:         "manager",
+			ProvisioningState: "Succeeded",
+			Tags:              map[string]string{"owner": "bob"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		id        string
+		fakeCalls *fakeResourceCalls
+		wantErr   bool
+		want      *pb.ReadResourceGroupReply
+	}{
+		{
+			name:      "Error: client returned an error",
+			id:        "name",
+			fakeCalls: &fakeResourceCalls{get: []any{errors.New("error")}},
+			wantErr:   true,
+		},
+		{
+			name:      "Error: ID is not a resource group",
+			id:        "/subscriptions/subscriptionID/resourceGroups/name/providers/Microsoft.Storage/storageAccounts/acct",
+			fakeCalls: &fakeResourceCalls{},
+			wantErr:   true,
+		},
+		{
+			name:      "Error: ID is for another subscription",
+			id:        "/subscriptions/other/resourceGroups/name",
+			fakeCalls: &fakeResourceCalls{},
+			wantErr:   true,
+		},
+		{
+			name: "Success: name",
+			id:   "name",
+			fakeCalls: &fakeResourceCalls{
+				get: []any{armresources.ResourceGroupsClientGetResponse{ResourceGroup: group}},
+			},
+			want: want,
+		},
+		{
+			name: "Success: ID",
+			id:   "/subscriptions/subscriptionID/resourceGroups/name",
+			fakeCalls: &fakeResourceCalls{
+				get: []any{armresources.ResourceGroupsClientGetResponse{ResourceGroup: group}},
+			},
+			want: want,
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient, subscriptionID: "subscriptionID"}
+		got, err := s.ReadResourceGroup(context.Background(), &pb.ReadResourceGroupRequest{Id: test.id})
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestReadResourceGroup(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestReadResourceGroup(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestReadResourceGroup(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestListResourceGroups(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeResourceCalls
+		wantErr   bool
+		want      *pb.ListResourceGroupsReply
+	}{
+		{
+			name:      "Error: pager returned an error",
+			fakeCalls: &fakeResourceCalls{list: []any{errors.New("error")}},
+			wantErr:   true,
+		},
+		{
+			name: "Success",
+			fakeCalls: &fakeResourceCalls{
+				list: []any{
+					armresources.ResourceGroupsClientListResponse{
+						ResourceGroupListResult: armresources.ResourceGroupListResult{
+							Value: []*armresources.ResourceGroup{
+								{ID: toPtr("id0"), Name: toPtr("name0"), Location: toPtr("westus")},
+							},
+						},
+					},
+					armresources.ResourceGroupsClientListResponse{
+						ResourceGroupListResult: armresources.ResourceGroupListResult{
+							Value: []*armresources.ResourceGroup{
+								{ID: toPtr("id1"), Name: toPtr("name1"), Location: toPtr("eastus")},
+							},
+						},
+					},
+				},
+			},
+			want: &pb.ListResourceGroupsReply{
+				ResourceGroups: []*pb.ResourceGroup{
+					{Id: "id0", Name: "name0", Region: "westus"},
+					{Id: "id1", Name: "name1", Region: "eastus"},
+				},
+			},
 		},
 	}
 
 	for _, test := range tests {
 		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
 		s := &Server{resourceClient: fakeClient}
-		_, err := s.DeleteResourceGroup(context.Background(), &pb.DeleteResourceGroupRequest{Id: "id"})
+		got, err := s.ListResourceGroups(context.Background(), &pb.ListResourceGroupsRequest{})
 		switch {
 		case err == nil && test.wantErr:
-			t.Errorf("TestCreateResourceGroup(%s): got err == nil, want err != nil", test.name)
+			t.Errorf("TestListResourceGroups(%s): got err == nil, want err != nil", test.name)
 			continue
 		case err != nil && !test.wantErr:
-			t.Errorf("TestCreateResourceGroup(%s): got err == %s, want err == nil", test.name, err)
+			t.Errorf("TestListResourceGroups(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestListResourceGroups(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestResourceGroupExists(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		id        string
+		fakeCalls *fakeResourceCalls
+		wantErr   bool
+		want      bool
+	}{
+		{
+			name:      "Error: invalid name",
+			id:        "bad name!",
+			fakeCalls: &fakeResourceCalls{},
+			wantErr:   true,
+		},
+		{
+			name:      "Error: client returned an error",
+			id:        "name",
+			fakeCalls: &fakeResourceCalls{checkExistence: []any{errors.New("error")}},
+			wantErr:   true,
+		},
+		{
+			name:      "Success: exists",
+			id:        "/subscriptions/subscriptionID/resourceGroups/name",
+			fakeCalls: &fakeResourceCalls{checkExistence: []any{true}},
+			want:      true,
+		},
+		{
+			name:      "Success: does not exist",
+			id:        "name",
+			fakeCalls: &fakeResourceCalls{checkExistence: []any{false}},
+			want:      false,
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient, subscriptionID: "subscriptionID"}
+		got, err := s.ResourceGroupExists(context.Background(), &pb.ResourceGroupExistsRequest{Id: test.id})
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestResourceGroupExists(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestResourceGroupExists(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		if got.Exists != test.want {
+			t.Errorf("TestResourceGroupExists(%s): got %v, want %v", test.name, got.Exists, test.want)
+		}
+	}
+}
+
+func TestUpdateResourceGroupTags(t *testing.T) {
+	t.Parallel()
+
+	current := armresources.ResourceGroupsClientGetResponse{
+		ResourceGroup: armresources.ResourceGroup{
+			Name: toPtr("name"),
+			Tags: map[string]*string{"owner": toPtr("bob")},
+		},
+	}
+	updated := armresources.ResourceGroupsClientUpdateResponse{}
+	tagsMask := &fieldmaskpb.FieldMask{Paths: []string{"Tags"}}
+
+	tests := []struct {
+		name      string
+		req       *pb.UpdateResourceGroupRequest
+		fakeCalls *fakeResourceCalls
+		wantErr   bool
+		wantTags  map[string]*string
+	}{
+		{
+			name:      "Error: no TagOperation",
+			req:       &pb.UpdateResourceGroupRequest{Name: "name", Tags: map[string]string{"env": "prod"}, UpdateMask: tagsMask},
+			fakeCalls: &fakeResourceCalls{},
+			wantErr:   true,
+		},
+		{
+			name: "Error: could not read existing tags",
+			req: &pb.UpdateResourceGroupRequest{
+				Name:         "name",
+				Tags:         map[string]string{"env": "prod"},
+				TagOperation: pb.TagOperation_TAG_OPERATION_MERGE,
+				UpdateMask:   tagsMask,
+			},
+			fakeCalls: &fakeResourceCalls{get: []any{errors.New("error")}},
+			wantErr:   true,
+		},
+		{
+			name: "Success: merge",
+			req: &pb.UpdateResourceGroupRequest{
+				Name:         "name",
+				Tags:         map[string]string{"env": "prod"},
+				TagOperation: pb.TagOperation_TAG_OPERATION_MERGE,
+				UpdateMask:   tagsMask,
+			},
+			fakeCalls: &fakeResourceCalls{get: []any{current}, update: []any{updated}},
+			wantTags:  map[string]*string{"owner": toPtr("bob"), "env": toPtr("prod")},
+		},
+		{
+			name: "Success: replace doesn't read existing tags",
+			req: &pb.UpdateResourceGroupRequest{
+				Name:         "name",
+				Tags:         map[string]string{"env": "prod"},
+				TagOperation: pb.TagOperation_TAG_OPERATION_REPLACE,
+				UpdateMask:   tagsMask,
+			},
+			fakeCalls: &fakeResourceCalls{update: []any{updated}},
+			wantTags:  map[string]*string{"env": toPtr("prod")},
+		},
+		{
+			name: "Success: delete",
+			req: &pb.UpdateResourceGroupRequest{
+				Name:         "name",
+				Tags:         map[string]string{"owner": ""},
+				TagOperation: pb.TagOperation_TAG_OPERATION_DELETE,
+				UpdateMask:   tagsMask,
+			},
+			fakeCalls: &fakeResourceCalls{get: []any{current}, update: []any{updated}},
+			wantTags:  map[string]*string{},
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient}
+		_, err := s.UpdateResourceGroup(context.Background(), test.req)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestUpdateResourceGroupTags(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestUpdateResourceGroupTags(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		if len(test.fakeCalls.gotUpdate) != 1 {
+			t.Errorf("TestUpdateResourceGroupTags(%s): got %d calls to Update, want 1", test.name, len(test.fakeCalls.gotUpdate))
+			continue
+		}
+		if diff := cmp.Diff(test.wantTags, test.fakeCalls.gotUpdate[0].Tags); diff != "" {
+			t.Errorf("TestUpdateResourceGroupTags(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestUpdateResourceGroup(t *testing.T) {
+	t.Parallel()
+
+	updated := armresources.ResourceGroupsClientUpdateResponse{
+		ResourceGroup: armresources.ResourceGroup{
+			ID:        toPtr("id"),
+			Name:      toPtr("name"),
+			Location:  toPtr("westus"),
+			ManagedBy: toPtr("manager"),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		req       *pb.UpdateResourceGroupRequest
+		fakeCalls *fakeResourceCalls
+		wantCode  codes.Code
+		wantPatch armresources.ResourceGroupPatchable
+		want      *pb.UpdateResourceGroupReply
+	}{
+		{
+			name:      "Error: no UpdateMask",
+			req:       &pb.UpdateResourceGroupRequest{Name: "name", ManagedBy: "manager"},
+			fakeCalls: &fakeResourceCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Error: Region is immutable",
+			req: &pb.UpdateResourceGroupRequest{
+				Name:       "name",
+				Region:     "eastus",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"Region"}},
+			},
+			fakeCalls: &fakeResourceCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Error: unknown field",
+			req: &pb.UpdateResourceGroupRequest{
+				Name:       "name",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"Properties"}},
+			},
+			fakeCalls: &fakeResourceCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Success: ManagedBy",
+			req: &pb.UpdateResourceGroupRequest{
+				Name:       "name",
+				ManagedBy:  "manager",
+				Tags:       map[string]string{"ignored": "because not in mask"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ManagedBy"}},
+			},
+			fakeCalls: &fakeResourceCalls{update: []any{updated}},
+			wantPatch: armresources.ResourceGroupPatchable{ManagedBy: toPtr("manager")},
+			want: &pb.UpdateResourceGroupReply{
+				Status:        "Success",
+				ResourceGroup: &pb.ResourceGroup{Id: "id", Name: "name", Region: "westus", ManagedBy: "manager"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient}
+		got, err := s.UpdateResourceGroup(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestUpdateResourceGroup(%s): got code %v, want %v", test.name, status.Code(err), test.wantCode)
+			continue
+		}
+		if err != nil {
 			continue
 		}
+		if len(test.fakeCalls.gotUpdate) != 1 {
+			t.Errorf("TestUpdateResourceGroup(%s): got %d calls to Update, want 1", test.name, len(test.fakeCalls.gotUpdate))
+			continue
+		}
+		if diff := cmp.Diff(test.wantPatch, test.fakeCalls.gotUpdate[0]); diff != "" {
+			t.Errorf("TestUpdateResourceGroup(%s): patch -want/+got:\n%s", test.name, diff)
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestUpdateResourceGroup(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestListResourceGroupsPaging(t *testing.T) {
+	t.Parallel()
+
+	listPage := func(names ...string) armresources.ResourceGroupsClientListResponse {
+		page := armresources.ResourceGroupsClientListResponse{}
+		for _, n := range names {
+			page.Value = append(page.Value, &armresources.ResourceGroup{Name: toPtr(n)})
+		}
+		return page
+	}
+
+	tests := []struct {
+		name      string
+		fakeCalls *fakeResourceCalls
+		pageSize  int32
+		// nextSub is the Subscription of the requests after the first, which has none.
+		nextSub string
+		want    [][]string
+	}{
+		{
+			name:      "Page ends inside an ARM page",
+			fakeCalls: &fakeResourceCalls{list: []any{listPage("a", "b", "c")}},
+			pageSize:  2,
+			want:      [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name:      "Page ends on an ARM page boundary",
+			fakeCalls: &fakeResourceCalls{list: []any{listPage("a", "b"), listPage("c", "d")}},
+			pageSize:  2,
+			want:      [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			name:      "Default subscription is named after the first page",
+			fakeCalls: &fakeResourceCalls{list: []any{listPage("a", "b", "c")}},
+			pageSize:  2,
+			nextSub:   "SubscriptionID",
+			want:      [][]string{{"a", "b"}, {"c"}},
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient, subscriptionID: "subscriptionID"}
+
+		req := &pb.ListResourceGroupsRequest{PageSize: test.pageSize}
+		var got [][]string
+		for i := 0; i < 10; i++ {
+			resp, err := s.ListResourceGroups(context.Background(), req)
+			if err != nil {
+				t.Fatalf("TestListResourceGroupsPaging(%s): got err == %s, want err == nil", test.name, err)
+			}
+			var names []string
+			for _, g := range resp.ResourceGroups {
+				names = append(names, g.Name)
+			}
+			got = append(got, names)
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+			req.Subscription = test.nextSub
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("TestListResourceGroupsPaging(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+
+	s := &Server{resourceClient: mustFakeResourceGroupClient(&fakeResourceCalls{})}
+	_, err := s.ListResourceGroups(context.Background(), &pb.ListResourceGroupsRequest{PageToken: "bad"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestListResourceGroupsPaging(bad token): got code %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	tok, err := s.encodePageToken(pageToken{Filter: listFilter{name: "prod"}.hash()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.ListResourceGroups(context.Background(), &pb.ListResourceGroupsRequest{Name: "dev", PageToken: tok})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestListResourceGroupsPaging(token for other filter): got code %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	tok, err = s.encodePageToken(pageToken{Filter: listFilter{subscription: "other"}.hash()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.ListResourceGroups(context.Background(), &pb.ListResourceGroupsRequest{PageToken: tok})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestListResourceGroupsPaging(token for other subscription): got code %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestStreamResourceGroups(t *testing.T) {
+	t.Parallel()
+
+	pages := []any{
+		armresources.ResourceGroupsClientListResponse{
+			ResourceGroupListResult: armresources.ResourceGroupListResult{
+				Value: []*armresources.ResourceGroup{
+					{Name: toPtr("prod-web"), Location: toPtr("westus")},
+					{Name: toPtr("dev-web"), Location: toPtr("westus")},
+				},
+			},
+		},
+		armresources.ResourceGroupsClientListResponse{
+			ResourceGroupListResult: armresources.ResourceGroupListResult{
+				Value: []*armresources.ResourceGroup{
+					{Name: toPtr("prod-db"), Location: toPtr("eastus")},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		req       *pb.StreamResourceGroupsRequest
+		fakeCalls *fakeResourceCalls
+		sendErr   error
+		wantErr   bool
+		want      []*pb.ResourceGroup
+	}{
+		{
+			name:      "Error: pager returned an error",
+			req:       &pb.StreamResourceGroupsRequest{},
+			fakeCalls: &fakeResourceCalls{list: []any{errors.New("error")}},
+			wantErr:   true,
+		},
+		{
+			name:      "Error: send failed",
+			req:       &pb.StreamResourceGroupsRequest{},
+			fakeCalls: &fakeResourceCalls{list: pages},
+			sendErr:   errors.New("error"),
+			wantErr:   true,
+		},
+		{
+			name:      "Success",
+			req:       &pb.StreamResourceGroupsRequest{Name: "prod-"},
+			fakeCalls: &fakeResourceCalls{list: pages},
+			want: []*pb.ResourceGroup{
+				{Name: "prod-web", Region: "westus"},
+				{Name: "prod-db", Region: "eastus"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		fakeClient := mustFakeResourceGroupClient(test.fakeCalls)
+		s := &Server{resourceClient: fakeClient}
+		stream := &fakeServerStream[*pb.ResourceGroup]{sendErr: test.sendErr}
+		err := s.StreamResourceGroups(test.req, stream)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestStreamResourceGroups(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestStreamResourceGroups(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		if diff := cmp.Diff(test.want, stream.sent, protocmp.Transform()); diff != "" {
+			t.Errorf("TestStreamResourceGroups(%s): -want/+got:\n%s", test.name, diff)
+		}
 	}
 }
 
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// opRecord is what is persisted for an operation.
+type opRecord struct {
+	Op          *pb.Operation
+	ResumeToken string
+}
+
+// opStore persists operations so that they can be recovered when the server restarts.
+type opStore interface {
+	// Put creates or replaces the record for rec.Op.Id.
+	Put(rec opRecord) error
+	// Delete removes the record for id. Deleting a record that doesn't exist is not an error.
+	Delete(id string) error
+	// List returns all records.
+	List() ([]opRecord, error)
+}
+
+// fileStore is an opStore that keeps each operation in a JSON file in a directory.
+type fileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// newFileStore creates a fileStore in dir, creating dir if it doesn't exist.
+func newFileStore(dir string) (*fileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("could not create operation store directory: %w", err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+// fileRecord is the on disk format of an opRecord.
+type fileRecord struct {
+	Operation   json.RawMessage `json:"operation"`
+	ResumeToken string          `json:"resumeToken,omitempty"`
+}
+
+// Put implements opStore.Put(). The file is written atomically, so a crash never leaves a partial record.
+func (f *fileStore) Put(rec opRecord) error {
+	b, err := protojson.Marshal(rec.Op)
+	if err != nil {
+		return err
+	}
+	b, err = json.Marshal(fileRecord{Operation: b, ResumeToken: rec.ResumeToken})
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path(rec.Op.GetId()))
+}
+
+// Delete implements opStore.Delete().
+func (f *fileStore) Delete(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.Remove(f.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List implements opStore.List().
+func (f *fileStore) List() ([]opRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var recs []opRecord
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(f.dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		fr := fileRecord{}
+		if err := json.Unmarshal(b, &fr); err != nil {
+			return nil, fmt.Errorf("operation record %s is corrupt: %w", e.Name(), err)
+		}
+		op := &pb.Operation{}
+		if err := protojson.Unmarshal(fr.Operation, op); err != nil {
+			return nil, fmt.Errorf("operation record %s is corrupt: %w", e.Name(), err)
+		}
+		recs = append(recs, opRecord{Op: op, ResumeToken: fr.ResumeToken})
+	}
+	return recs, nil
+}
+
+func (f *fileStore) path(id string) string {
+	return filepath.Join(f.dir, id+".json")
+}
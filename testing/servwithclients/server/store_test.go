@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+
+	store, err := newFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("TestFileStore: newFileStore() got err == %s, want err == nil", err)
+	}
+
+	recs := []opRecord{
+		{Op: &pb.Operation{Id: "a", Kind: "kind", State: pb.OperationState_OPERATION_STATE_RUNNING, CreateTime: timestamppb.Now()}, ResumeToken: "token"},
+		{Op: &pb.Operation{Id: "b", Kind: "kind", State: pb.OperationState_OPERATION_STATE_SUCCEEDED}},
+	}
+	for _, rec := range recs {
+		if err := store.Put(rec); err != nil {
+			t.Fatalf("TestFileStore: Put() got err == %s, want err == nil", err)
+		}
+	}
+	// Replacing a record must not create a second one.
+	if err := store.Put(recs[1]); err != nil {
+		t.Fatalf("TestFileStore: Put() got err == %s, want err == nil", err)
+	}
+	if err := store.Delete("missing"); err != nil {
+		t.Errorf("TestFileStore: Delete(missing) got err == %s, want err == nil", err)
+	}
+
+	got, err := store.List()
+	if err != nil {
+		t.Fatalf("TestFileStore: List() got err == %s, want err == nil", err)
+	}
+	if diff := cmp.Diff(recs, got, protocmp.Transform(), cmp.AllowUnexported(opRecord{})); diff != "" {
+		t.Errorf("TestFileStore: -want/+got:\n%s", diff)
+	}
+
+	if err := store.Delete("a"); err != nil {
+		t.Fatalf("TestFileStore: Delete() got err == %s, want err == nil", err)
+	}
+	got, err = store.List()
+	if err != nil {
+		t.Fatalf("TestFileStore: List() got err == %s, want err == nil", err)
+	}
+	if len(got) != 1 || got[0].Op.Id != "b" {
+		t.Errorf("TestFileStore: after Delete(a) got %v, want only b", got)
+	}
+}
+
+func TestOperationsRecover(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := newFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := &operations{pollFrequency: time.Millisecond, store: store}
+	running := before.start("resumable", "target", &fakePoller{polls: []any{http.StatusAccepted}, token: "token", block: make(chan struct{})})
+	lost := before.start("unknown", "target", &fakePoller{polls: []any{http.StatusAccepted}, token: "token", block: make(chan struct{})})
+	done := before.start("resumable", "target", &fakePoller{polls: []any{http.StatusOK}})
+	<-done.done
+
+	// Simulate a restart by loading the same store into new operations.
+	after := &operations{pollFrequency: time.Millisecond, store: store}
+	err = after.recover(func(kind, target, token string) (lroPoller, error) {
+		if kind != "resumable" || token != "token" {
+			return nil, errors.New("can't resume")
+		}
+		return &fakePoller{polls: []any{http.StatusAccepted, http.StatusOK}}, nil
+	})
+	if err != nil {
+		t.Fatalf("TestOperationsRecover: recover() got err == %s, want err == nil", err)
+	}
+
+	wants := map[string]pb.OperationState{
+		running.proto().Id: pb.OperationState_OPERATION_STATE_SUCCEEDED,
+		lost.proto().Id:    pb.OperationState_OPERATION_STATE_FAILED,
+		done.proto().Id:    pb.OperationState_OPERATION_STATE_SUCCEEDED,
+	}
+	for id, want := range wants {
+		op, ok := after.get(id)
+		if !ok {
+			t.Errorf("TestOperationsRecover: operation %s was not recovered", id)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		select {
+		case <-op.done:
+		case <-ctx.Done():
+		}
+		cancel()
+		if got := op.proto().State; got != want {
+			t.Errorf("TestOperationsRecover: operation %s got state %v, want %v", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+// GetTagsAtScope reads the tags on a subscription, resource group or resource.
+func (s *Server) GetTagsAtScope(ctx context.Context, in *pb.GetTagsAtScopeRequest) (*pb.TagsAtScope, error) {
+	id, err := parseResourceID("Scope", in.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.tagsClientFor(id.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAtScope(ctx, in.GetScope(), nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	return toPBTagsAtScope(in.GetScope(), resp.TagsResource), nil
+}
+
+// PatchTagsAtScope merges, replaces or deletes the tags on a subscription, resource group or resource.
+// It returns the tags on the scope after the patch.
+func (s *Server) PatchTagsAtScope(ctx context.Context, in *pb.PatchTagsAtScopeRequest) (*pb.TagsAtScope, error) {
+	id, err := parseResourceID("Scope", in.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	v := violations{}
+	op, ok := tagsPatchOperations[in.GetTagOperation()]
+	switch {
+	case in.GetTagOperation() == pb.TagOperation_TAG_OPERATION_UNSPECIFIED:
+		v.add("TagOperation", "is required")
+	case !ok:
+		v.add("TagOperation", "unknown TagOperation %v", in.GetTagOperation())
+	case len(in.GetTags()) == 0 && in.GetTagOperation() != pb.TagOperation_TAG_OPERATION_REPLACE:
+		v.add("Tags", "is required unless TagOperation is TAG_OPERATION_REPLACE")
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	client, err := s.tagsClientFor(id.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[string]*string{}
+	for k, v := range in.GetTags() {
+		v := v
+		tags[k] = &v
+	}
+	if in.GetTagOperation() == pb.TagOperation_TAG_OPERATION_DELETE {
+		// ARM only deletes a tag if the value matches, but values are ignored by TAG_OPERATION_DELETE.
+		// So we delete the keys with the values they have now.
+		resp, err := client.GetAtScope(ctx, in.GetScope(), nil)
+		if err != nil {
+			return nil, azError(err)
+		}
+		current := toPBTagsAtScope(in.GetScope(), resp.TagsResource)
+		tags = map[string]*string{}
+		for k := range in.GetTags() {
+			if v, ok := current.Tags[k]; ok {
+				v := v
+				tags[k] = &v
+			}
+		}
+		if len(tags) == 0 {
+			return current, nil
+		}
+	}
+
+	patch := armresources.TagsPatchResource{Operation: &op, Properties: &armresources.Tags{Tags: tags}}
+	resp, err := client.UpdateAtScope(ctx, in.GetScope(), patch, nil)
+	if err != nil {
+		return nil, azError(err)
+	}
+	// The tags of a resource group are part of the cached group.
+	if strings.EqualFold(id.ResourceType.String(), arm.ResourceGroupResourceType.String()) {
+		s.invalidateGroup(id.SubscriptionID, id.ResourceGroupName)
+	}
+	return toPBTagsAtScope(in.GetScope(), resp.TagsResource), nil
+}
+
+// ListTagNames lists the tag names used in a subscription.
+func (s *Server) ListTagNames(ctx context.Context, in *pb.ListTagNamesRequest) (*pb.ListTagNamesReply, error) {
+	size, err := pageSize(in.GetPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sub := in.GetSubscription()
+	if sub == "" {
+		sub = s.subscriptionID
+	}
+	tok, err := s.requestPageToken(in.GetPageToken(), scopeHash("/subscriptions/"+sub+"/tagNames"))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.tagsClientFor(in.GetSubscription())
+	if err != nil {
+		return nil, err
+	}
+	page := func(p armresources.TagsClientListResponse) ([]*armresources.TagDetails, *string) {
+		return p.Value, p.NextLink
+	}
+	items, next, err := readPage(ctx, client.NewListPager(nil), tok, size, page)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListTagNamesReply{TagNames: make([]*pb.TagName, 0, len(items))}
+	for _, d := range items {
+		reply.TagNames = append(reply.TagNames, toPBTagName(*d))
+	}
+	if reply.NextPageToken, err = s.nextPageToken(next); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// tagsPatchOperations maps a TagOperation to the ARM operation that patches tags at a scope.
+var tagsPatchOperations = map[pb.TagOperation]armresources.TagsPatchOperation{
+	pb.TagOperation_TAG_OPERATION_MERGE:   armresources.TagsPatchOperationMerge,
+	pb.TagOperation_TAG_OPERATION_REPLACE: armresources.TagsPatchOperationReplace,
+	pb.TagOperation_TAG_OPERATION_DELETE:  armresources.TagsPatchOperationDelete,
+}
+
+func toPBTagsAtScope(scope string, r armresources.TagsResource) *pb.TagsAtScope {
+	t := &pb.TagsAtScope{Scope: scope}
+	if r.Properties != nil {
+		t.Tags = toPBTags(r.Properties.Tags)
+	}
+	return t
+}
+
+func toPBTagName(d armresources.TagDetails) *pb.TagName {
+	n := &pb.TagName{Name: deref(d.TagName), Count: tagCount(d.Count)}
+	for _, v := range d.Values {
+		if v == nil {
+			continue
+		}
+		n.Values = append(n.Values, &pb.TagValueCount{Value: deref(v.TagValue), Count: tagCount(v.Count)})
+	}
+	return n
+}
+
+func tagCount(c *armresources.TagCount) int32 {
+	if c == nil {
+		return 0
+	}
+	return deref(c.Value)
+}
+
+// needsCurrentTags reports if applying op requires knowing the existing tags.
+func needsCurrentTags(op pb.TagOperation) bool {
+	return op != pb.TagOperation_TAG_OPERATION_REPLACE
+}
+
+// applyTags applies tags to current according to op and returns the full set of tags
+// to send to ARM, which always replaces the tags on a resource group with what it is sent.
+// current is not modified.
+func applyTags(current map[string]*string, op pb.TagOperation, tags map[string]string) (map[string]*string, error) {
+	out := map[string]*string{}
+
+	switch op {
+	case pb.TagOperation_TAG_OPERATION_MERGE:
+		for k, v := range current {
+			out[k] = v
+		}
+		for k, v := range tags {
+			v := v
+			out[k] = &v
+		}
+	case pb.TagOperation_TAG_OPERATION_REPLACE:
+		for k, v := range tags {
+			v := v
+			out[k] = &v
+		}
+	case pb.TagOperation_TAG_OPERATION_DELETE:
+		for k, v := range current {
+			if _, ok := tags[k]; ok {
+				continue
+			}
+			out[k] = v
+		}
+	default:
+		return nil, fmt.Errorf("unknown TagOperation %v", op)
+	}
+	return out, nil
+}
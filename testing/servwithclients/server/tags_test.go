@@ -0,0 +1,347 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources/fake"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	pb "github.com/element-of-surprise/examples/testing/servwithclients/server/proto"
+)
+
+func mustFakeTagsClient(calls *fakeTagsCalls) tagsClient {
+	fs := newTagsServer(calls)
+	client, err := armresources.NewTagsClient(
+		"subscriptionID",
+		&azfake.TokenCredential{},
+		&arm.ClientOptions{
+			ClientOptions: azcore.ClientOptions{
+				Transport: fake.NewTagsServerTransport(&fs),
+			},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// tagsResource is a TagsResource holding tags.
+func tagsResource(tags map[string]*string) armresources.TagsResource {
+	return armresources.TagsResource{Properties: &armresources.Tags{Tags: tags}}
+}
+
+func TestApplyTags(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]*string{"owner": toPtr("bob"), "cost": toPtr("123")}
+
+	tests := []struct {
+		name    string
+		op      pb.TagOperation
+		tags    map[string]string
+		want    map[string]*string
+		wantErr bool
+	}{
+		{
+			name: "Merge",
+			op:   pb.TagOperation_TAG_OPERATION_MERGE,
+			tags: map[string]string{"owner": "alice", "env": "prod"},
+			want: map[string]*string{"owner": toPtr("alice"), "cost": toPtr("123"), "env": toPtr("prod")},
+		},
+		{
+			name: "Replace",
+			op:   pb.TagOperation_TAG_OPERATION_REPLACE,
+			tags: map[string]string{"env": "prod", "team": "infra"},
+			want: map[string]*string{"env": toPtr("prod"), "team": toPtr("infra")},
+		},
+		{
+			name: "Replace with nothing",
+			op:   pb.TagOperation_TAG_OPERATION_REPLACE,
+			want: map[string]*string{},
+		},
+		{
+			name: "Delete",
+			op:   pb.TagOperation_TAG_OPERATION_DELETE,
+			tags: map[string]string{"owner": "", "missing": ""},
+			want: map[string]*string{"cost": toPtr("123")},
+		},
+		{
+			name:    "Error: unspecified operation",
+			op:      pb.TagOperation_TAG_OPERATION_UNSPECIFIED,
+			wantErr: true,
+		},
+		{
+			name:    "Error: unknown operation",
+			op:      pb.TagOperation(100),
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := applyTags(current, test.op, test.tags)
+		switch {
+		case err == nil && test.wantErr:
+			t.Errorf("TestApplyTags(%s): got err == nil, want err != nil", test.name)
+			continue
+		case err != nil && !test.wantErr:
+			t.Errorf("TestApplyTags(%s): got err == %s, want err == nil", test.name, err)
+			continue
+		case err != nil:
+			continue
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("TestApplyTags(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+	if *current["owner"] != "bob" || len(current) != 2 {
+		t.Errorf("TestApplyTags: current was modified")
+	}
+}
+
+func TestGetTagsAtScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		scope     string
+		fakeCalls *fakeTagsCalls
+		noClient  bool
+		wantCode  codes.Code
+		want      *pb.TagsAtScope
+	}{
+		{
+			name:      "Error: scope is not an ID",
+			scope:     "vnet",
+			fakeCalls: &fakeTagsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: scope is not in a subscription",
+			scope:     "/providers/Microsoft.Management/managementGroups/mg",
+			fakeCalls: &fakeTagsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: scope is in another subscription",
+			scope:     "/subscriptions/other",
+			fakeCalls: &fakeTagsCalls{},
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			name:     "Error: no tags client",
+			scope:    vnetID,
+			noClient: true,
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name:      "Error: GetAtScope() returned an error",
+			scope:     vnetID,
+			fakeCalls: &fakeTagsCalls{getAtScope: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name:  "Success",
+			scope: vnetID,
+			fakeCalls: &fakeTagsCalls{getAtScope: []any{
+				armresources.TagsClientGetAtScopeResponse{TagsResource: tagsResource(map[string]*string{"owner": toPtr("bob")})},
+			}},
+			want: &pb.TagsAtScope{Scope: vnetID, Tags: map[string]string{"owner": "bob"}},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{subscriptionID: "subscriptionID"}
+		if !test.noClient {
+			s.tagsClient = mustFakeTagsClient(test.fakeCalls)
+		}
+		got, err := s.GetTagsAtScope(context.Background(), &pb.GetTagsAtScopeRequest{Scope: test.scope})
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestGetTagsAtScope(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestGetTagsAtScope(%s): -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestPatchTagsAtScope(t *testing.T) {
+	t.Parallel()
+
+	current := armresources.TagsClientGetAtScopeResponse{
+		TagsResource: tagsResource(map[string]*string{"owner": toPtr("bob"), "cost": toPtr("123")}),
+	}
+	after := armresources.TagsClientUpdateAtScopeResponse{
+		TagsResource: tagsResource(map[string]*string{"cost": toPtr("123")}),
+	}
+
+	tests := []struct {
+		name      string
+		req       *pb.PatchTagsAtScopeRequest
+		fakeCalls *fakeTagsCalls
+		wantCode  codes.Code
+		wantPatch *armresources.TagsPatchResource
+		want      *pb.TagsAtScope
+	}{
+		{
+			name:      "Error: no TagOperation",
+			req:       &pb.PatchTagsAtScopeRequest{Scope: vnetID, Tags: map[string]string{"owner": "bob"}},
+			fakeCalls: &fakeTagsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: no tags to merge",
+			req:       &pb.PatchTagsAtScopeRequest{Scope: vnetID, TagOperation: pb.TagOperation_TAG_OPERATION_MERGE},
+			fakeCalls: &fakeTagsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: unknown operation",
+			req:       &pb.PatchTagsAtScopeRequest{Scope: vnetID, TagOperation: pb.TagOperation(100), Tags: map[string]string{"owner": "bob"}},
+			fakeCalls: &fakeTagsCalls{},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "Error: UpdateAtScope() returned an error",
+			req:       &pb.PatchTagsAtScopeRequest{Scope: vnetID, TagOperation: pb.TagOperation_TAG_OPERATION_MERGE, Tags: map[string]string{"owner": "bob"}},
+			fakeCalls: &fakeTagsCalls{updateAtScope: []any{errors.New("error")}},
+			wantCode:  codes.Unknown,
+		},
+		{
+			name:      "Success: merge",
+			req:       &pb.PatchTagsAtScopeRequest{Scope: vnetID, TagOperation: pb.TagOperation_TAG_OPERATION_MERGE, Tags: map[string]string{"cost": "123"}},
+			fakeCalls: &fakeTagsCalls{updateAtScope: []any{after}},
+			wantPatch: &armresources.TagsPatchResource{
+				Operation:  toPtr(armresources.TagsPatchOperationMerge),
+				Properties: &armresources.Tags{Tags: map[string]*string{"cost": toPtr("123")}},
+			},
+			want: &pb.TagsAtScope{Scope: vnetID, Tags: map[string]string{"cost": "123"}},
+		},
+		{
+			name:      "Success: replace with nothing",
+			req:       &pb.PatchTagsAtScopeRequest{Scope: vnetID, TagOperation: pb.TagOperation_TAG_OPERATION_REPLACE},
+			fakeCalls: &fakeTagsCalls{updateAtScope: []any{armresources.TagsClientUpdateAtScopeResponse{}}},
+			wantPatch: &armresources.TagsPatchResource{
+				Operation:  toPtr(armresources.TagsPatchOperationReplace),
+				Properties: &armresources.Tags{Tags: map[string]*string{}},
+			},
+			want: &pb.TagsAtScope{Scope: vnetID},
+		},
+		{
+			name: "Success: delete ignores values",
+			req: &pb.PatchTagsAtScopeRequest{
+				Scope:        vnetID,
+				TagOperation: pb.TagOperation_TAG_OPERATION_DELETE,
+				Tags:         map[string]string{"owner": "", "missing": ""},
+			},
+			fakeCalls: &fakeTagsCalls{getAtScope: []any{current}, updateAtScope: []any{after}},
+			wantPatch: &armresources.TagsPatchResource{
+				Operation:  toPtr(armresources.TagsPatchOperationDelete),
+				Properties: &armresources.Tags{Tags: map[string]*string{"owner": toPtr("bob")}},
+			},
+			want: &pb.TagsAtScope{Scope: vnetID, Tags: map[string]string{"cost": "123"}},
+		},
+		{
+			name: "Success: delete tags that are not there",
+			req: &pb.PatchTagsAtScopeRequest{
+				Scope:        vnetID,
+				TagOperation: pb.TagOperation_TAG_OPERATION_DELETE,
+				Tags:         map[string]string{"missing": ""},
+			},
+			fakeCalls: &fakeTagsCalls{getAtScope: []any{current}},
+			want:      &pb.TagsAtScope{Scope: vnetID, Tags: map[string]string{"owner": "bob", "cost": "123"}},
+		},
+	}
+
+	for _, test := range tests {
+		s := &Server{subscriptionID: "subscriptionID", tagsClient: mustFakeTagsClient(test.fakeCalls)}
+		got, err := s.PatchTagsAtScope(context.Background(), test.req)
+		if status.Code(err) != test.wantCode {
+			t.Errorf("TestPatchTagsAtScope(%s): got code %v, want %v: %s", test.name, status.Code(err), test.wantCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("TestPatchTagsAtScope(%s): -want/+got:\n%s", test.name, diff)
+		}
+		var gotPatch *armresources.TagsPatchResource
+		if len(test.fakeCalls.gotPatches) > 0 {
+			gotPatch = &test.fakeCalls.gotPatches[0]
+		}
+		if diff := cmp.Diff(test.wantPatch, gotPatch); diff != "" {
+			t.Errorf("TestPatchTagsAtScope(%s): patch -want/+got:\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestListTagNames(t *testing.T) {
+	t.Parallel()
+
+	page := armresources.TagsClientListResponse{
+		TagsListResult: armresources.TagsListResult{
+			Value: []*armresources.TagDetails{
+				{
+					TagName: toPtr("owner"),
+					Count:   &armresources.TagCount{Type: toPtr("Total"), Value: toPtr[int32](3)},
+					Values: []*armresources.TagValue{
+						{TagValue: toPtr("bob"), Count: &armresources.TagCount{Value: toPtr[int32](2)}},
+						{TagValue: toPtr("alice"), Count: &armresources.TagCount{Value: toPtr[int32](1)}},
+					},
+				},
+				nil,
+				{TagName: toPtr("unused")},
+			},
+		},
+	}
+
+	calls := &fakeTagsCalls{list: []any{page}}
+	s := &Server{tagsClient: mustFakeTagsClient(calls)}
+	req := &pb.ListTagNamesRequest{PageSize: 1}
+	var got []*pb.TagName
+	for i := 0; i < 10; i++ {
+		resp, err := s.ListTagNames(context.Background(), req)
+		if err != nil {
+			t.Fatalf("TestListTagNames: got err == %s, want err == nil", err)
+		}
+		got = append(got, resp.TagNames...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	want := []*pb.TagName{
+		{
+			Name:  "owner",
+			Count: 3,
+			Values: []*pb.TagValueCount{
+				{Value: "bob", Count: 2},
+				{Value: "alice", Count: 1},
+			},
+		},
+		{Name: "unused"},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("TestListTagNames: -want/+got:\n%s", diff)
+	}
+
+	_, err := s.ListTagNames(context.Background(), &pb.ListTagNamesRequest{Subscription: "other", PageToken: req.PageToken})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestListTagNames(token for other subscription): got code %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
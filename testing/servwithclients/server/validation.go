@@ -0,0 +1,105 @@
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRGNameLen is the maximum length of a resource group name in characters.
+const maxRGNameLen = 90
+
+// maxDeploymentNameLen is the maximum length of a deployment name in characters.
+const maxDeploymentNameLen = 64
+
+// deploymentNameRE matches the characters allowed in a deployment name.
+var deploymentNameRE = regexp.MustCompile(`^[-\w._()]+$`)
+
+// providerNamespaceRE matches a resource provider namespace, such as "Microsoft.Network".
+var providerNamespaceRE = regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)+$`)
+
+// rgNameRE matches the characters allowed in a resource group name: Unicode letters and digits,
+// underscores, parentheses, hyphens and periods.
+var rgNameRE = regexp.MustCompile(`^[\p{L}\p{N}_().-]+$`)
+
+// violations collects the problems with the fields of a request.
+type violations []*errdetails.BadRequest_FieldViolation
+
+// add records that field is invalid, with a description of why.
+func (v *violations) add(field, format string, a ...any) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{Field: field, Description: fmt.Sprintf(format, a...)})
+}
+
+// err returns nil if there are no violations, otherwise an InvalidArgument error with the violations
+// attached as an errdetails.BadRequest.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	descs := make([]string, 0, len(v))
+	for _, fv := range v {
+		descs = append(descs, fv.Field+": "+fv.Description)
+	}
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(descs, "; "))
+	withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// validateRGName checks that name follows Azure's naming rules for resource groups.
+func validateRGName(v *violations, field, name string) {
+	switch {
+	case name == "":
+		v.add(field, "resource group name is required")
+	case utf8.RuneCountInString(name) > maxRGNameLen:
+		v.add(field, "resource group name must be at most %d characters", maxRGNameLen)
+	case !rgNameRE.MatchString(name):
+		v.add(field, "resource group name can only contain letters, digits, underscores, parentheses, hyphens and periods")
+	case strings.HasSuffix(name, "."):
+		v.add(field, "resource group name cannot end with a period")
+	}
+}
+
+// validateDeploymentName checks that name follows Azure's naming rules for deployments.
+func validateDeploymentName(v *violations, field, name string) {
+	switch {
+	case name == "":
+		v.add(field, "deployment name is required")
+	case len(name) > maxDeploymentNameLen:
+		v.add(field, "deployment name must be at most %d characters", maxDeploymentNameLen)
+	case !deploymentNameRE.MatchString(name):
+		v.add(field, "deployment name can only contain letters, digits, underscores, parentheses, hyphens and periods")
+	}
+}
+
+// validateProviderNamespace checks that namespace looks like a resource provider namespace.
+func validateProviderNamespace(v *violations, field, namespace string) {
+	switch {
+	case namespace == "":
+		v.add(field, "provider namespace is required")
+	case !providerNamespaceRE.MatchString(namespace):
+		v.add(field, "provider namespace must be dot separated letters and digits, such as Microsoft.Network")
+	}
+}
+
+// validateRegion checks that region is set and, if the server has a list of allowed regions, is in it.
+func (s *Server) validateRegion(v *violations, field, region string) {
+	if region == "" {
+		v.add(field, "region is required")
+		return
+	}
+	if len(s.allowedRegions) == 0 {
+		return
+	}
+	if _, ok := s.allowedRegions[normalizeRegion(region)]; !ok {
+		v.add(field, "region %q is not allowed", region)
+	}
+}